@@ -1,14 +1,28 @@
 package blackhole
 
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
 // Blueprint represents a blueprint for defining database tables or modifying them.
 type Blueprint struct {
 	mode        string // todo: enum
 	table       string
+	renameTo    string
 	charSet     string
 	collate     string
+	options     TableOptions
+	partitions  *Partitioning
 	grammar     *Grammar
 	definitions []Definition
 	children    []*Blueprint
+	enumTypes   []*EnumType
+	err         error
 }
 
 // NewBlueprint creates a new Blueprint instance with the specified table name.
@@ -25,6 +39,13 @@ func (b *Blueprint) Create(callback func(*Blueprint)) *Blueprint {
 	return b
 }
 
+// CreateIfNotExists is for creating a new table unless it already exists. It sets the mode to "createIfNotExists" and invokes the callback function.
+func (b *Blueprint) CreateIfNotExists(callback func(*Blueprint)) *Blueprint {
+	b.setMode("createIfNotExists")
+	callback(b)
+	return b
+}
+
 // Alter is for altering an existing table. It sets the mode to "alter" and invokes the callback function.
 func (b *Blueprint) Alter(callback func(*Blueprint)) *Blueprint {
 	b.setMode("alter")
@@ -39,16 +60,46 @@ func (b *Blueprint) Drop(callback func(*Blueprint)) *Blueprint {
 	return b
 }
 
+// DropIfExists is for dropping a table if it exists. It sets the mode to "dropIfExists" and invokes the callback function.
+func (b *Blueprint) DropIfExists(callback func(*Blueprint)) *Blueprint {
+	b.setMode("dropIfExists")
+	callback(b)
+	return b
+}
+
+// Rename is for renaming an existing table. It sets the mode to "rename".
+func (b *Blueprint) Rename(to string) *Blueprint {
+	b.setMode("rename")
+	b.renameTo = to
+	return b
+}
+
+// Truncate is for removing all rows of an existing table. It sets the mode to "truncate".
+func (b *Blueprint) Truncate() *Blueprint {
+	b.setMode("truncate")
+	return b
+}
+
 // GetTable returns the name of the table associated with the blueprint.
 func (b *Blueprint) GetTable() string {
 	return b.table
 }
 
-// Mode returns the mode (create, alter, drop) of the blueprint.
+// GetRenameTo returns the new name of the table when the blueprint renames it.
+func (b *Blueprint) GetRenameTo() string {
+	return b.renameTo
+}
+
+// Mode returns the mode (create, alter, drop, etc.) of the blueprint.
 func (b *Blueprint) Mode() string {
 	return b.mode
 }
 
+// isCreating returns whether the blueprint creates its table, conditionally or not.
+func (b *Blueprint) isCreating() bool {
+	return b.mode == "create" || b.mode == "createIfNotExists"
+}
+
 // setMode sets the mode (create, alter, drop) of the blueprint.
 func (b *Blueprint) setMode(mode string) {
 	b.mode = mode
@@ -119,6 +170,34 @@ func (b *Blueprint) DateTime(column string) *Column {
 	return col
 }
 
+// DateTimeTz creates a new timezone aware datetime column and adds it to the blueprint.
+func (b *Blueprint) DateTimeTz(column string) *Column {
+	col := dateTimeTzColumn(column)
+	b.addColumn(col)
+	return col
+}
+
+// Timestamp creates a new timestamp column and adds it to the blueprint.
+func (b *Blueprint) Timestamp(column string) *Column {
+	col := timestampColumn(column)
+	b.addColumn(col)
+	return col
+}
+
+// TimestampTz creates a new timezone aware timestamp column and adds it to the blueprint.
+func (b *Blueprint) TimestampTz(column string) *Column {
+	col := timestampTzColumn(column)
+	b.addColumn(col)
+	return col
+}
+
+// Year creates a new year column and adds it to the blueprint.
+func (b *Blueprint) Year(column string) *Column {
+	col := yearColumn(column)
+	b.addColumn(col)
+	return col
+}
+
 // Time creates a new time column and adds it to the blueprint.
 func (b *Blueprint) Time(column string) *Column {
 	col := timeColumn(column)
@@ -189,34 +268,221 @@ func (b *Blueprint) Enum(name string, values []string) *Column {
 	return col
 }
 
+// Json creates a new json column and adds it to the blueprint.
+func (b *Blueprint) Json(column string) *Column {
+	col := jsonColumn(column)
+	b.addColumn(col)
+	return col
+}
+
+// EnumType creates a new column of the named enum type and adds it to the blueprint, see Schema.CreateEnumType.
+func (b *Blueprint) EnumType(name, enumType string) *Column {
+	col := NewColumn(name, ColumnTypeEnum, 0).EnumType(enumType)
+	b.addColumn(col)
+	return col
+}
+
+// Geometry creates a new geometry column and adds it to the blueprint.
+func (b *Blueprint) Geometry(column string) *Column {
+	col := spatialColumn(column, ColumnTypeGeometry)
+	b.addColumn(col)
+	return col
+}
+
+// Point creates a new point column and adds it to the blueprint.
+func (b *Blueprint) Point(column string) *Column {
+	col := spatialColumn(column, ColumnTypePoint)
+	b.addColumn(col)
+	return col
+}
+
+// LineString creates a new linestring column and adds it to the blueprint.
+func (b *Blueprint) LineString(column string) *Column {
+	col := spatialColumn(column, ColumnTypeLineString)
+	b.addColumn(col)
+	return col
+}
+
+// Polygon creates a new polygon column and adds it to the blueprint.
+func (b *Blueprint) Polygon(column string) *Column {
+	col := spatialColumn(column, ColumnTypePolygon)
+	b.addColumn(col)
+	return col
+}
+
+// MultiPolygon creates a new multipolygon column and adds it to the blueprint.
+func (b *Blueprint) MultiPolygon(column string) *Column {
+	col := spatialColumn(column, ColumnTypeMultiPolygon)
+	b.addColumn(col)
+	return col
+}
+
+// GeometryCollection creates a new geometrycollection column and adds it to the blueprint.
+func (b *Blueprint) GeometryCollection(column string) *Column {
+	col := spatialColumn(column, ColumnTypeGeometryCollection)
+	b.addColumn(col)
+	return col
+}
+
+// AlterEnum changes the values of an existing enum column. The previous definition of the column is
+// required, as its nullability, default and comment are carried over to the new definition.
+// Values can only be added to a column of a named enum type, see AddEnumValues.
+func (b *Blueprint) AlterEnum(previous *Column, values ...string) *Column {
+	if previous.GetEnumType() != "" && b.err == nil {
+		b.err = fmt.Errorf("column %q: the values of the enum type %q can only be added to", previous.GetName(), previous.GetEnumType())
+	}
+	col := previous.clone()
+	col.enumValues = &EnumValues{Values: values}
+	col.blueprint = b
+	b.addAlterDefinition(NewModifyColumn(col))
+	return col
+}
+
+// AddEnumValues adds values to an existing enum column, keeping its previous values and attributes.
+// For a column of a named enum type the values are added to the type, ahead of the other changes of the
+// blueprint, and the column itself is left as it is.
+func (b *Blueprint) AddEnumValues(previous *Column, values ...string) *Column {
+	if previous.GetEnumType() != "" {
+		b.enumTypes = append(b.enumTypes, NewEnumType(previous.GetEnumType(), values...).setMode("alter"))
+		return previous
+	}
+	var merged []string
+	if previous.GetEnumValues() != nil {
+		merged = append(merged, previous.GetEnumValues().Values...)
+	}
+	for _, v := range values {
+		if !slices.Contains(merged, v) {
+			merged = append(merged, v)
+		}
+	}
+	return b.AlterEnum(previous, merged...)
+}
+
 // Timestamps adds created_at and updated_at timestamp columns to the blueprint.
-func (b *Blueprint) Timestamps() {
-	created := timestampColumn("created_at").
-		Default("CURRENT_TIMESTAMP")
-	updated := timestampColumn("updated_at").
-		Default("CURRENT_TIMESTAMP on update CURRENT_TIMESTAMP")
+// An optional fractional seconds precision can be given, e.g. 6 for microseconds.
+func (b *Blueprint) Timestamps(precision ...int) {
+	b.addTimestamps(ColumnTypeTimestamp, precision...)
+}
+
+// TimestampsTz adds timezone aware created_at and updated_at timestamp columns to the blueprint.
+// An optional fractional seconds precision can be given, e.g. 6 for microseconds.
+func (b *Blueprint) TimestampsTz(precision ...int) {
+	b.addTimestamps(ColumnTypeTimestampTz, precision...)
+}
+
+// NullableTimestamps adds nullable created_at and updated_at timestamp columns without defaults to the blueprint.
+// An optional fractional seconds precision can be given, e.g. 6 for microseconds.
+func (b *Blueprint) NullableTimestamps(precision ...int) {
+	p := fractionalPrecision(precision)
+	b.addColumn(timestampColumn("created_at").Precision(p).Nullable())
+	b.addColumn(timestampColumn("updated_at").Precision(p).Nullable())
+}
+
+// addTimestamps adds created_at and updated_at columns of the given type, defaulting to the current time.
+func (b *Blueprint) addTimestamps(dataType ColumnType, precision ...int) {
+	p := fractionalPrecision(precision)
+	now := "CURRENT_TIMESTAMP"
+	if p > 0 {
+		now += "(" + strconv.Itoa(p) + ")"
+	}
+	created := NewColumn("created_at", dataType, 0).
+		Precision(p).
+		Default(now)
+	updated := NewColumn("updated_at", dataType, 0).
+		Precision(p).
+		Default(now + " on update " + now)
 	b.addColumn(created)
 	b.addColumn(updated)
 }
 
+// fractionalPrecision returns the optional fractional seconds precision, or 0 if none is given.
+func fractionalPrecision(precision []int) int {
+	if len(precision) == 0 {
+		return 0
+	}
+	return precision[0]
+}
+
 // Build builds the SQL statement from the blueprint using the associated grammar.
 func (b *Blueprint) Build() (string, error) {
 	return (*b.grammar).Build(b)
 }
 
+// buildStatements builds the blueprint into its individual SQL statements: a statement of its own, or one per
+// definition, table options and partitioning when altering, followed by the statements of its children.
+// Each statement is built on its own, so none has to be split out of the SQL of the whole blueprint.
+func (b *Blueprint) buildStatements() ([]string, error) {
+	var parts []*Blueprint
+	part := func(definitions []Definition, options TableOptions, partitions *Partitioning) {
+		p := *b
+		p.definitions, p.options, p.partitions, p.children = definitions, options, partitions, nil
+		parts = append(parts, &p)
+	}
+	if b.mode == "alter" {
+		for _, d := range b.definitions {
+			part([]Definition{d}, TableOptions{}, nil)
+		}
+		if !b.options.IsEmpty() {
+			part(nil, b.options, nil)
+		}
+		if b.partitions != nil {
+			part(nil, TableOptions{}, b.partitions)
+		}
+	} else {
+		part(b.definitions, b.options, b.partitions)
+	}
+
+	var statements []string
+	for _, p := range parts {
+		sql, err := p.Build()
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, sql)
+	}
+	for _, child := range b.children {
+		childStatements, err := child.buildStatements()
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, childStatements...)
+	}
+	return statements, nil
+}
+
 // AddChild adds a child blueprint to the current blueprint.
 func (b *Blueprint) AddChild(child *Blueprint) {
 	b.children = append(b.children, child)
 }
 
+// usesEnumType returns whether a column the blueprint or its children define or modify is of the named enum type.
+func (b *Blueprint) usesEnumType(name string) bool {
+	for _, d := range b.definitions {
+		if c, ok := d.(*Column); ok && c.GetEnumType() == name {
+			return true
+		}
+	}
+	for _, m := range b.modifiedColumns() {
+		if m.Column().GetEnumType() == name {
+			return true
+		}
+	}
+	return slices.ContainsFunc(b.children, func(child *Blueprint) bool { return child.usesEnumType(name) })
+}
+
+// isEmpty returns whether altering the blueprint changes nothing.
+func (b *Blueprint) isEmpty() bool {
+	return len(b.definitions) == 0 && len(b.children) == 0 && b.options.IsEmpty() && b.partitions == nil
+}
+
 // Children returns the child blueprints of the current blueprint.
 func (b *Blueprint) Children() []*Blueprint {
 	return b.children
 }
 
-// AddIndex adds an index definition to the blueprint.
+// AddIndex adds an index definition to the blueprint or creates a child blueprint if necessary.
 func (b *Blueprint) AddIndex(index *Index) {
-	b.definitions = append(b.definitions, index)
+	b.addAlterDefinition(index)
 }
 
 // IndexColumn adds an index to the blueprint.
@@ -241,6 +507,132 @@ func (b *Blueprint) IndexColumns(columns ...string) *Index {
 	return index
 }
 
+// IndexExpression adds a named index over the given expression key parts to the blueprint.
+func (b *Blueprint) IndexExpression(name string, expressions ...string) *Index {
+	index := &Index{
+		Name:        name,
+		Type:        IndexTypeIndex,
+		Table:       b.GetTable(),
+		Expressions: expressions,
+	}
+	b.AddIndex(index)
+	return index
+}
+
+// Primary adds a (composite) primary key over the given columns to the blueprint.
+func (b *Blueprint) Primary(columns ...string) *Index {
+	index := &Index{
+		Type:    IndexTypePrimary,
+		Table:   b.GetTable(),
+		Columns: columns,
+	}
+	b.AddIndex(index)
+	return index
+}
+
+// SpatialIndex adds a spatial index to the blueprint.
+func (b *Blueprint) SpatialIndex(columns ...string) *Index {
+	index := &Index{
+		Type:    IndexTypeSpatial,
+		Table:   b.GetTable(),
+		Columns: columns,
+	}
+	b.AddIndex(index)
+	return index
+}
+
+// FullText adds a full-text index to the blueprint.
+func (b *Blueprint) FullText(columns ...string) *Index {
+	index := &Index{
+		Type:    IndexTypeFullText,
+		Table:   b.GetTable(),
+		Columns: columns,
+	}
+	b.AddIndex(index)
+	return index
+}
+
+// indexes returns the index definitions of the blueprint and its children.
+func (b *Blueprint) indexes() []*Index {
+	var indexes []*Index
+	for _, d := range b.Definitions() {
+		if i, ok := d.(*Index); ok {
+			indexes = append(indexes, i)
+		}
+	}
+	for _, child := range b.Children() {
+		indexes = append(indexes, child.indexes()...)
+	}
+	return indexes
+}
+
+// modifiedColumns returns the column modifications of the blueprint and its children.
+func (b *Blueprint) modifiedColumns() []*ModifyColumn {
+	var modified []*ModifyColumn
+	for _, d := range b.Definitions() {
+		if m, ok := d.(*ModifyColumn); ok {
+			modified = append(modified, m)
+		}
+	}
+	for _, child := range b.Children() {
+		modified = append(modified, child.modifiedColumns()...)
+	}
+	return modified
+}
+
+// foreignKeys returns the foreign key definitions of the blueprint and its children.
+func (b *Blueprint) foreignKeys() []*ForeignKey {
+	var foreignKeys []*ForeignKey
+	for _, d := range b.Definitions() {
+		if fk, ok := d.(*ForeignKey); ok {
+			foreignKeys = append(foreignKeys, fk)
+		}
+	}
+	for _, child := range b.Children() {
+		foreignKeys = append(foreignKeys, child.foreignKeys()...)
+	}
+	return foreignKeys
+}
+
+// ForeignKeys returns the foreign key definitions of the blueprint, including those added to its children.
+func (b *Blueprint) ForeignKeys() []*ForeignKey {
+	return b.foreignKeys()
+}
+
+// foreignKeyOf returns the foreign key defined on the given column, or nil if there is none.
+func (b *Blueprint) foreignKeyOf(column string) *ForeignKey {
+	for _, fk := range b.foreignKeys() {
+		if fk.GetColumn() == column {
+			return fk
+		}
+	}
+	return nil
+}
+
+// leadsIndex returns whether the given column is the leading column of an index of the blueprint.
+func (b *Blueprint) leadsIndex(column string) bool {
+	for _, i := range b.indexes() {
+		if len(i.Columns) > 0 && i.Columns[0] == column {
+			return true
+		}
+	}
+	return false
+}
+
+// indexForeignKeys adds an index for every foreign key column that does not already lead an index.
+func (b *Blueprint) indexForeignKeys() {
+	for _, fk := range b.foreignKeys() {
+		if b.leadsIndex(fk.GetColumn()) {
+			continue
+		}
+		b.AddIndex(&Index{
+			Type:    IndexTypeIndex,
+			Table:   b.GetTable(),
+			Columns: []string{fk.GetColumn()},
+		})
+	}
+}
+
 // Collate sets the collation for the blueprint.
 func (b *Blueprint) Collate(collate string) {
 	b.collate = collate
@@ -269,9 +661,91 @@ func (b *Blueprint) GetCollation() string {
 	return b.collate
 }
 
+// Engine sets the storage engine for the blueprint.
+func (b *Blueprint) Engine(engine string) {
+	b.options.Engine = engine
+}
+
+// Comment sets the table comment for the blueprint.
+func (b *Blueprint) Comment(comment string) {
+	b.options.Comment = NewComment(comment)
+}
+
+// RowFormat sets the row format for the blueprint.
+func (b *Blueprint) RowFormat(format string) {
+	b.options.RowFormat = format
+}
+
+// StartingValue sets the value the auto-increment column of the table starts from.
+func (b *Blueprint) StartingValue(value int) {
+	b.options.StartingValue = value
+}
+
+// KeyBlockSize sets the key block size, in kilobytes, for the blueprint.
+func (b *Blueprint) KeyBlockSize(size int) {
+	b.options.KeyBlockSize = size
+}
+
+// PartitionByRange partitions the table by ranges of the given column or expression.
+func (b *Blueprint) PartitionByRange(by string, partitions ...*Partition) {
+	b.partitions = &Partitioning{Type: PartitionTypeRange, By: by, Partitions: partitions}
+}
+
+// PartitionByList partitions the table by lists of values of the given column or expression.
+func (b *Blueprint) PartitionByList(by string, partitions ...*Partition) {
+	b.partitions = &Partitioning{Type: PartitionTypeList, By: by, Partitions: partitions}
+}
+
+// PartitionByHash partitions the table into the given number of partitions by the hash of the given expression.
+func (b *Blueprint) PartitionByHash(by string, count int) {
+	b.partitions = &Partitioning{Type: PartitionTypeHash, By: by, Count: count}
+}
+
+// PartitionByKey partitions the table into the given number of partitions by the given key columns.
+// Without columns, the primary key of the table is used.
+func (b *Blueprint) PartitionByKey(count int, columns ...string) {
+	b.partitions = &Partitioning{Type: PartitionTypeKey, By: strings.Join(columns, ", "), Count: count}
+}
+
+// GetPartitioning returns the partitioning of the blueprint, or nil if the table is not partitioned.
+func (b *Blueprint) GetPartitioning() *Partitioning {
+	return b.partitions
+}
+
+// AddPartition adds partitions to a partitioned table.
+func (b *Blueprint) AddPartition(partitions ...*Partition) {
+	b.addAlterDefinition(&AddPartition{Partitions: partitions})
+}
+
+// DropPartition drops partitions, and the rows they hold, from a partitioned table.
+func (b *Blueprint) DropPartition(names ...string) {
+	b.addAlterDefinition(&DropPartition{Names: names})
+}
+
+// ReorganizePartition merges or splits the named partitions into the given ones.
+func (b *Blueprint) ReorganizePartition(names []string, into ...*Partition) {
+	b.addAlterDefinition(&ReorganizePartition{Names: names, Into: into})
+}
+
+// GetOptions returns the table options of the blueprint.
+func (b *Blueprint) GetOptions() TableOptions {
+	return b.options
+}
+
 // SoftDeletes adds a nullable "deleted_at" timestamp column to the blueprint for soft deletion support.
-func (b *Blueprint) SoftDeletes() {
+// An optional fractional seconds precision can be given, e.g. 6 for microseconds.
+func (b *Blueprint) SoftDeletes(precision ...int) {
 	deleted := timestampColumn("deleted_at").
+		Precision(fractionalPrecision(precision)).
+		Nullable()
+	b.addColumn(deleted)
+}
+
+// SoftDeletesTz adds a nullable timezone aware "deleted_at" timestamp column to the blueprint for soft deletion support.
+// An optional fractional seconds precision can be given, e.g. 6 for microseconds.
+func (b *Blueprint) SoftDeletesTz(precision ...int) {
+	deleted := timestampTzColumn("deleted_at").
+		Precision(fractionalPrecision(precision)).
 		Nullable()
 	b.addColumn(deleted)
 }
@@ -295,23 +769,87 @@ func (b *Blueprint) ForeignId(column string) (*ForeignKey, *Column) {
 	return fk, col
 }
 
-// RenameColumn adds a column rename definition to the blueprint or creates a child blueprint if necessary.
-func (b *Blueprint) RenameColumn(old, new string) {
-	rename := NewRenameColumn(old, new)
+// Check adds a named check constraint to the blueprint or creates a child blueprint if necessary.
+func (b *Blueprint) Check(name, expression string) *Check {
+	check := NewCheck(name, expression)
+	b.addAlterDefinition(check)
+	return check
+}
+
+// DropCheck adds a drop check constraint definition to the blueprint or creates a child blueprint if necessary.
+func (b *Blueprint) DropCheck(name string) {
+	b.addAlterDefinition(NewDropCheck(name))
+}
+
+// addAlterDefinition adds a definition that can only be compiled as a table alteration.
+// In alter mode it is added to the blueprint itself, otherwise a child blueprint is created for it.
+func (b *Blueprint) addAlterDefinition(definition Definition) {
 	if b.mode == "alter" {
-		b.definitions = append(b.definitions, rename)
+		b.definitions = append(b.definitions, definition)
 		return
 	}
 
-	child := &Blueprint{
+	b.AddChild(&Blueprint{
 		table:   b.GetTable(),
 		grammar: b.grammar,
 		mode:    "alter",
 		definitions: []Definition{
-			rename,
+			definition,
 		},
+	})
+}
+
+// ForeignIdFor creates a foreign key column for the given model, deriving the column and the referenced
+// table from its Go type name, e.g. OrderItem becomes "order_item_id" referencing "order_items" ("id").
+// A nil model or one of an unnamed type, such as an anonymous struct, fails the build of the schema.
+func (b *Blueprint) ForeignIdFor(model any) (*ForeignKey, *Column) {
+	name := modelName(model)
+	if name == "" {
+		if b.err == nil {
+			b.err = fmt.Errorf("foreign id for %T: the model has no type name to derive the column from", model)
+		}
+		// The key and column are not added to the blueprint, they are only returned so that calls can be chained.
+		return NewForeignKey("", b.GetTable()), bigIntColumn("").Unsigned()
 	}
-	b.AddChild(child)
+	fk, col := b.ForeignId(name + "_id")
+	fk.On(pluralize.NewClient().Plural(name), "id")
+	return fk, col
+}
+
+// Morphs adds the "{name}_type" and "{name}_id" columns of a polymorphic relation to the blueprint,
+// along with a composite index over both.
+func (b *Blueprint) Morphs(name string) {
+	b.addMorphs(name, bigIntColumn(name+"_id").Unsigned(), false)
+}
+
+// NullableMorphs adds nullable polymorphic relation columns to the blueprint, see Morphs.
+func (b *Blueprint) NullableMorphs(name string) {
+	b.addMorphs(name, bigIntColumn(name+"_id").Unsigned(), true)
+}
+
+// UuidMorphs adds polymorphic relation columns with a uuid id column to the blueprint, see Morphs.
+func (b *Blueprint) UuidMorphs(name string) {
+	b.addMorphs(name, charColumn(name+"_id", 36), false)
+}
+
+// addMorphs adds the type column and the given id column of a polymorphic relation, and their composite index.
+func (b *Blueprint) addMorphs(name string, id *Column, nullable bool) {
+	morphType := stringColumn(name+"_type", 255)
+	if nullable {
+		morphType.Nullable()
+		id.Nullable()
+	} else {
+		morphType.NotNull()
+		id.NotNull()
+	}
+	b.addColumn(morphType)
+	b.addColumn(id)
+	b.IndexColumns(morphType.GetName(), id.GetName())
+}
+
+// RenameColumn adds a column rename definition to the blueprint or creates a child blueprint if necessary.
+func (b *Blueprint) RenameColumn(old, new string) {
+	b.addAlterDefinition(NewRenameColumn(old, new))
 }
 
 // DropColumn adds a drop column definition as a child blueprint.
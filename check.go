@@ -0,0 +1,54 @@
+package blackhole
+
+// Check represents a named check constraint on a table.
+type Check struct {
+	Definition
+	name       string
+	expression string
+}
+
+// NewCheck creates a new check constraint with the specified name and boolean expression.
+func NewCheck(name, expression string) *Check {
+	return &Check{
+		name:       name,
+		expression: expression,
+	}
+}
+
+// GetName returns the name of the check constraint.
+func (c *Check) GetName() string {
+	return c.name
+}
+
+// GetExpression returns the boolean expression of the check constraint.
+func (c *Check) GetExpression() string {
+	return c.expression
+}
+
+// Expression generates the SQL expression for the check constraint using the provided grammar.
+func (c *Check) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileCheck(c)
+}
+
+// DropCheck represents the removal of a named check constraint from a table.
+type DropCheck struct {
+	Definition
+	name string
+}
+
+// NewDropCheck creates a new drop check definition for the specified constraint name.
+func NewDropCheck(name string) *DropCheck {
+	return &DropCheck{
+		name: name,
+	}
+}
+
+// Name returns the name of the check constraint to drop.
+func (d *DropCheck) Name() string {
+	return d.name
+}
+
+// Expression generates the SQL expression for dropping the check constraint using the provided grammar.
+func (d *DropCheck) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileDropCheck(d.name)
+}
@@ -0,0 +1,66 @@
+// Command blackhole scaffolds and runs the migrations of a project.
+//
+//	blackhole make:migration create_users_table
+//	blackhole migrate
+//	blackhole migrate:rollback -step 2
+//	blackhole migrate:status
+//	blackhole migrate:fresh
+//	blackhole schema:dump
+//	blackhole make:models -path models/models_gen.go
+//
+// make:migration writes a migration file, and the main.go running them, to the migrations package. The other
+// commands build and run that package with "go run", as the migrations are Go code compiled into it along with
+// the database driver. Settings are read from the environment or an env file, see "blackhole help".
+//
+// make:models needs no database, and can be run by go generate in the package the models are generated into:
+//
+//	//go:generate go run github.com/uutkukorkmaz/blackhole/cmd/blackhole make:models -dir ../migrations
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/uutkukorkmaz/blackhole/migrate"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	options, err := migrate.ParseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	switch options.Command {
+	case "migrate", "migrate:rollback", "migrate:status", "migrate:fresh", "schema:dump", "make:models":
+	default:
+		return migrate.Run(context.Background(), args, os.Stdout)
+	}
+
+	// Every command is passed on as given, the migrations package reads the same settings.
+	cmd := exec.Command("go", append([]string{"run", packagePath(options.Config.Dir)}, args...)...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+// packagePath returns the directory in the form go run takes as a package path.
+func packagePath(dir string) string {
+	if filepath.IsAbs(dir) {
+		return dir
+	}
+	return "." + string(filepath.Separator) + filepath.Clean(dir)
+}
@@ -1,5 +1,7 @@
 package blackhole
 
+import "slices"
+
 // Column represents a database column with various attributes such as type, length, precision, etc.
 type Column struct {
 	Definition
@@ -11,10 +13,17 @@ type Column struct {
 	unsigned       bool
 	primary        bool
 	autoIncrements *AutoIncrements
+	identity       *Identity
 	nullable       *Nullable
 	defaultValue   *DefaultValue
 	comment        *Comment
 	enumValues     *EnumValues
+	enumType       string
+	charSet        string
+	collation      string
+	check          string
+	generated      *Generated
+	srid           int
 	blueprint      *Blueprint
 }
 
@@ -32,6 +41,23 @@ func NewColumn(name string, dataType ColumnType, length int) *Column {
 // AutoIncrement sets the column to auto increment.
 func (c *Column) AutoIncrement() *Column {
 	c.autoIncrements = &AutoIncrements{}
+	c.identity = nil
+	return c
+}
+
+// GeneratedByDefaultAsIdentity makes the column an identity column that fills in missing values from a sequence
+// with the given options.
+func (c *Column) GeneratedByDefaultAsIdentity(options SequenceOptions) *Column {
+	c.identity = NewIdentity(false, options)
+	c.autoIncrements = nil
+	return c
+}
+
+// GeneratedAlwaysAsIdentity makes the column an identity column that always takes its values from a sequence
+// with the given options, rejecting explicit values.
+func (c *Column) GeneratedAlwaysAsIdentity(options SequenceOptions) *Column {
+	c.identity = NewIdentity(true, options)
+	c.autoIncrements = nil
 	return c
 }
 
@@ -77,7 +103,8 @@ func (c *Column) setNullable(state bool) *Column {
 	return c
 }
 
-// Precision sets the precision for a numeric column.
+// Precision sets the precision of a numeric column, or the fractional seconds precision of a time,
+// datetime or timestamp column.
 func (c *Column) Precision(precision int) *Column {
 	c.precision = precision
 	return c
@@ -95,6 +122,69 @@ func (c *Column) Enum(values ...string) *Column {
 	return c
 }
 
+// EnumType sets the named enum type the column is of, see Schema.CreateEnumType.
+func (c *Column) EnumType(name string) *Column {
+	c.enumType = name
+	return c
+}
+
+// CharSet sets the character set of a string column.
+func (c *Column) CharSet(charSet string) *Column {
+	c.charSet = charSet
+	return c
+}
+
+// Collation sets the collation of a string column.
+func (c *Column) Collation(collation string) *Column {
+	c.collation = collation
+	return c
+}
+
+// Check adds a check constraint with the given boolean expression to the column.
+func (c *Column) Check(expression string) *Column {
+	c.check = expression
+	return c
+}
+
+// VirtualAs makes the column a virtual generated column computed from the expression on read.
+func (c *Column) VirtualAs(expression string) *Column {
+	c.generated = NewGenerated(expression, false)
+	return c
+}
+
+// StoredAs makes the column a stored generated column computed from the expression on write.
+func (c *Column) StoredAs(expression string) *Column {
+	c.generated = NewGenerated(expression, true)
+	return c
+}
+
+// SRID sets the spatial reference system identifier of a spatial column.
+func (c *Column) SRID(srid int) *Column {
+	c.srid = srid
+	return c
+}
+
+// clone returns a copy of the column whose modifiers can be changed without changing the column.
+func (c *Column) clone() *Column {
+	clone := *c
+	if c.nullable != nil {
+		nullable := *c.nullable
+		clone.nullable = &nullable
+	}
+	if c.defaultValue != nil {
+		defaultValue := *c.defaultValue
+		clone.defaultValue = &defaultValue
+	}
+	if c.comment != nil {
+		comment := *c.comment
+		clone.comment = &comment
+	}
+	if c.enumValues != nil {
+		clone.enumValues = &EnumValues{Values: slices.Clone(c.enumValues.Values)}
+	}
+	return &clone
+}
+
 // Expression generates the column definition SQL using the provided grammar.
 func (c *Column) Expression(grammar Grammar) (string, error) {
 	return grammar.CompileColumn(c)
@@ -130,6 +220,11 @@ func (c *Column) GetAutoIncrements() *AutoIncrements {
 	return c.autoIncrements
 }
 
+// GetIdentity returns the identity of the column, or nil if it is not an identity column.
+func (c *Column) GetIdentity() *Identity {
+	return c.identity
+}
+
 // GetNullable returns the nullable setting of the column.
 func (c *Column) GetNullable() *Nullable {
 	return c.nullable
@@ -150,6 +245,36 @@ func (c *Column) GetEnumValues() *EnumValues {
 	return c.enumValues
 }
 
+// GetEnumType returns the named enum type of the column, or an empty string if it has none.
+func (c *Column) GetEnumType() string {
+	return c.enumType
+}
+
+// GetCharSet returns the character set of the column, or an empty string if the table default applies.
+func (c *Column) GetCharSet() string {
+	return c.charSet
+}
+
+// GetCollation returns the collation of the column, or an empty string if the table default applies.
+func (c *Column) GetCollation() string {
+	return c.collation
+}
+
+// GetCheck returns the check constraint expression of the column.
+func (c *Column) GetCheck() string {
+	return c.check
+}
+
+// GetGenerated returns the generated column expression of the column.
+func (c *Column) GetGenerated() *Generated {
+	return c.generated
+}
+
+// GetSRID returns the spatial reference system identifier of the column, or 0 if none is set.
+func (c *Column) GetSRID() int {
+	return c.srid
+}
+
 // IsUnsigned returns whether the column is unsigned.
 func (c *Column) IsUnsigned() bool {
 	return c.unsigned
@@ -205,6 +330,19 @@ func (c *Column) Index() *Column {
 	return c
 }
 
+// Constrained makes sure the column is backed by a foreign key constraint and an index.
+// A conventional foreign key is created if the column has none yet, and the index is skipped if the column
+// already leads another index of the table.
+func (c *Column) Constrained() *Column {
+	if c.blueprint.foreignKeyOf(c.name) == nil {
+		c.blueprint.addAlterDefinition(NewForeignKey(c.name, c.blueprint.GetTable()))
+	}
+	if !c.blueprint.leadsIndex(c.name) {
+		c.Index()
+	}
+	return c
+}
+
 // ForeignKey creates a foreign key constraint for the column.
 func (c *Column) ForeignKey() *ForeignKey {
 	foreignKey := &ForeignKey{
@@ -0,0 +1,75 @@
+package blackhole
+
+import "fmt"
+
+// DatabaseOptions holds the options a database is created with.
+type DatabaseOptions struct {
+	CharSet   string
+	Collation string
+}
+
+// Database represents a database to be created or dropped.
+type Database struct {
+	mode    string
+	name    string
+	options DatabaseOptions
+	grammar *Grammar
+}
+
+// NewDatabase creates a new Database instance with the specified name and options.
+func NewDatabase(name string, options DatabaseOptions) *Database {
+	return &Database{
+		name:    name,
+		options: options,
+	}
+}
+
+// Grammar sets the grammar for the database.
+func (d *Database) Grammar(grammar *Grammar) {
+	d.grammar = grammar
+}
+
+// GetName returns the name of the database.
+func (d *Database) GetName() string {
+	return d.name
+}
+
+// Mode returns the mode (create, createIfNotExists, drop, dropIfExists) of the database.
+func (d *Database) Mode() string {
+	return d.mode
+}
+
+// setMode sets the mode of the database.
+func (d *Database) setMode(mode string) *Database {
+	d.mode = mode
+	return d
+}
+
+// GetCharSet returns the character set of the database. If not set, it returns the default character set from the grammar.
+func (d *Database) GetCharSet() string {
+	if d.options.CharSet == "" {
+		cs, _ := (*d.grammar).GetDefaultCharset()
+		return cs
+	}
+	return d.options.CharSet
+}
+
+// GetCollation returns the collation of the database. If not set, it returns the default collation from the grammar.
+func (d *Database) GetCollation() string {
+	if d.options.Collation == "" {
+		col, _ := (*d.grammar).GetDefaultCollation()
+		return col
+	}
+	return d.options.Collation
+}
+
+// Build builds the SQL statement for the database using the associated grammar.
+func (d *Database) Build() (string, error) {
+	switch d.mode {
+	case "create", "createIfNotExists":
+		return (*d.grammar).CompileCreateDatabase(d)
+	case "drop", "dropIfExists":
+		return (*d.grammar).CompileDropDatabase(d)
+	}
+	return "", fmt.Errorf("blackhole: invalid database mode given : %s", d.mode)
+}
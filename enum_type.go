@@ -0,0 +1,87 @@
+package blackhole
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EnumType represents a named enum type, such as a Postgres enum, that columns of several tables can share.
+type EnumType struct {
+	mode    string
+	name    string
+	values  []string
+	grammar *Grammar
+}
+
+// NewEnumType creates a new EnumType instance with the specified name and values.
+func NewEnumType(name string, values ...string) *EnumType {
+	return &EnumType{
+		name:   name,
+		values: values,
+	}
+}
+
+// Grammar sets the grammar for the enum type.
+func (e *EnumType) Grammar(grammar *Grammar) {
+	e.grammar = grammar
+}
+
+// GetName returns the name of the enum type.
+func (e *EnumType) GetName() string {
+	return e.name
+}
+
+// GetValues returns the values the enum type is created with, or the values added to it when altering.
+func (e *EnumType) GetValues() []string {
+	return e.values
+}
+
+// Mode returns the mode (create, alter, drop, dropIfExists) of the enum type.
+func (e *EnumType) Mode() string {
+	return e.mode
+}
+
+// setMode sets the mode of the enum type.
+func (e *EnumType) setMode(mode string) *EnumType {
+	e.mode = mode
+	return e
+}
+
+// Build builds the SQL statement for the enum type using the associated grammar.
+// Values are added to an existing type one statement at a time.
+func (e *EnumType) Build() (string, error) {
+	switch e.mode {
+	case "create":
+		return (*e.grammar).CompileCreateEnumType(e)
+	case "alter":
+		statements, err := e.buildStatements()
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(statements, "\n"), nil
+	case "drop", "dropIfExists":
+		return (*e.grammar).CompileDropEnumType(e)
+	}
+	return "", fmt.Errorf("blackhole: invalid enum type mode given : %s", e.mode)
+}
+
+// buildStatements builds the enum type into its individual SQL statements, one for every added value when
+// altering, as databases such as Postgres add a single value per statement.
+func (e *EnumType) buildStatements() ([]string, error) {
+	if e.mode != "alter" {
+		sql, err := e.Build()
+		if err != nil {
+			return nil, err
+		}
+		return []string{sql}, nil
+	}
+	statements := make([]string, 0, len(e.values))
+	for _, value := range e.values {
+		sql, err := (*e.grammar).CompileAddEnumTypeValue(e, value)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, sql)
+	}
+	return statements, nil
+}
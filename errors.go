@@ -0,0 +1,6 @@
+package blackhole
+
+import "errors"
+
+// ErrNotSupported is returned by a grammar when a definition uses a feature its dialect does not support.
+var ErrNotSupported = errors.New("blackhole: not supported by the grammar")
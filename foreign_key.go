@@ -52,25 +52,23 @@ func (f *ForeignKey) Expression(grammar Grammar) (string, error) {
 	return grammar.CompileForeignKey(f)
 }
 
-// discoverReferences automatically discovers the referenced table and column based on the column name if they are not explicitly set.
+// discoverReferences sets the referenced table and column discovered from the column name if they are not
+// explicitly set. It runs once the foreign key is built.
 func (f *ForeignKey) discoverReferences() {
-	if f.referencedTable != "" && f.referencedColumn != "" {
-		return
-	}
+	f.referencedTable, f.referencedColumn = f.References()
+}
 
-	if !f.autoDiscover {
-		return
+// References returns the referenced table and column as set through On or, if they are not set, as they will be
+// discovered from the column name when the foreign key is built, e.g. "users" and "id" for "user_id".
+func (f *ForeignKey) References() (table, column string) {
+	if f.referencedTable != "" && f.referencedColumn != "" || !f.autoDiscover {
+		return f.referencedTable, f.referencedColumn
 	}
 
 	// Use a pluralizer to infer the referenced table name based on the column name.
 	p := pluralize.NewClient()
 	parts := strings.Split(f.column, "_")
-	columnPart := strings.Join(parts[1:], "_")
-	tablePart := parts[0]
-	f.referencedTable = p.Plural(tablePart)
-	f.referencedColumn = columnPart
-
-	return
+	return p.Plural(parts[0]), strings.Join(parts[1:], "_")
 }
 
 // On sets the referenced table and column for the foreign key.
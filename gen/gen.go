@@ -0,0 +1,429 @@
+// Package gen generates Go model structs from the tables of a blackhole schema.
+//
+// Every table created on the schema becomes a struct with `db` and `json` tags, with later alter, rename and drop
+// blueprints on the schema applied to it. Nullable columns become pointer or sql.Null* fields, enum columns become
+// typed string constants and foreign keys are documented on the fields holding them.
+//
+// Models have to be generated before the schema is built, as building a schema clears its statements. The
+// make:models command of the migrate package generates the models of the tables its migrations create, and can be
+// run by go generate:
+//
+//	//go:generate go run github.com/uutkukorkmaz/blackhole/cmd/blackhole make:models -dir ../migrations
+//
+// Models are generated from schemas only, introspecting the tables of a database is not supported.
+package gen
+
+import (
+	"fmt"
+	"go/format"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/uutkukorkmaz/blackhole"
+)
+
+// NullStyle decides how nullable columns are represented in the generated structs.
+type NullStyle int
+
+const (
+	// NullPointer represents a nullable column as a pointer to its type, e.g. *string.
+	NullPointer NullStyle = iota
+	// NullSQL represents a nullable column as a database/sql null wrapper, e.g. sql.NullString.
+	NullSQL
+)
+
+// ParseNullStyle returns the NullStyle named "pointer" or "sql".
+func ParseNullStyle(name string) (NullStyle, error) {
+	switch name {
+	case "pointer":
+		return NullPointer, nil
+	case "sql":
+		return NullSQL, nil
+	}
+	return 0, fmt.Errorf("blackhole: gen: invalid null style %q, expected pointer or sql", name)
+}
+
+// Options holds the options models are generated with.
+type Options struct {
+	// Package is the name of the package the generated file belongs to. It defaults to "models".
+	Package string
+	// NullStyle decides how nullable columns are represented.
+	NullStyle NullStyle
+}
+
+// initialisms are the words written in all capitals in Go identifiers.
+var initialisms = map[string]bool{
+	"api": true, "cpu": true, "css": true, "dns": true, "html": true, "http": true, "https": true, "id": true,
+	"ip": true, "json": true, "sku": true, "sql": true, "ssh": true, "tcp": true, "tls": true, "ttl": true,
+	"ui": true, "uid": true, "uri": true, "url": true, "utc": true, "uuid": true, "xml": true,
+}
+
+// packages maps the package qualifiers used in generated field types to their import paths.
+var packages = map[string]string{
+	"sql.":  "database/sql",
+	"json.": "encoding/json",
+	"time.": "time",
+}
+
+// sqlNullTypes maps Go types to the database/sql null wrapper holding them. Other types use sql.Null[T].
+var sqlNullTypes = map[string]string{
+	"string":    "sql.NullString",
+	"int64":     "sql.NullInt64",
+	"int32":     "sql.NullInt32",
+	"int16":     "sql.NullInt16",
+	"uint8":     "sql.NullByte",
+	"bool":      "sql.NullBool",
+	"float64":   "sql.NullFloat64",
+	"time.Time": "sql.NullTime",
+}
+
+// field is a column of a model, under its current name.
+type field struct {
+	name   string
+	column *blackhole.Column
+}
+
+// declarations maps the identifiers declared in a scope of the generated source to what they were declared for.
+type declarations map[string]string
+
+// declare records the identifier as declared for what, failing if it was already declared for something else,
+// as the generated source would not compile.
+func (d declarations) declare(name, what string) error {
+	if previous, ok := d[name]; ok {
+		return fmt.Errorf("blackhole: gen: %s would be declared for both %s and %s", name, previous, what)
+	}
+	d[name] = what
+	return nil
+}
+
+// model is the struct generated for a table.
+type model struct {
+	table       string
+	fields      []*field
+	foreignKeys []*blackhole.ForeignKey
+}
+
+// Generate returns the formatted Go source of the models of the tables created on the schema. It fails if tables,
+// columns or enum values would be generated under the same identifier, as the source would not compile.
+func Generate(schema *blackhole.Schema, options Options) ([]byte, error) {
+	if options.Package == "" {
+		options.Package = "models"
+	}
+
+	models := collectModels(schema)
+	var b strings.Builder
+	b.WriteString("// Code generated by blackhole/gen. DO NOT EDIT.\n\n")
+	b.WriteString("package " + options.Package + "\n\n")
+
+	var body strings.Builder
+	imports := map[string]bool{}
+	declared := declarations{}
+	for _, m := range models {
+		if err := writeModel(&body, m, options, imports, declared); err != nil {
+			return nil, err
+		}
+	}
+
+	writeImports(&b, imports)
+	b.WriteString(body.String())
+
+	source, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("blackhole: gen: formatting generated source: %w", err)
+	}
+	return source, nil
+}
+
+// collectModels replays the blueprints of the schema and returns the models of the tables that exist at its end,
+// in the order the tables were created.
+func collectModels(schema *blackhole.Schema) []*model {
+	var models []*model
+	find := func(table string) int {
+		return slices.IndexFunc(models, func(m *model) bool { return m.table == table })
+	}
+
+	for _, bp := range schema.Blueprints() {
+		i := find(bp.GetTable())
+		switch bp.Mode() {
+		case "create", "createIfNotExists":
+			m := &model{table: bp.GetTable()}
+			m.apply(bp)
+			if i >= 0 {
+				models[i] = m
+			} else {
+				models = append(models, m)
+			}
+		case "alter":
+			if i >= 0 {
+				models[i].apply(bp)
+			}
+		case "rename":
+			if i >= 0 {
+				models[i].table = bp.GetRenameTo()
+			}
+		case "drop", "dropIfExists":
+			if i >= 0 {
+				models = slices.Delete(models, i, i+1)
+			}
+		}
+	}
+	return models
+}
+
+// apply adds, modifies, renames and drops the columns of the blueprint and its children on the model,
+// and records their foreign keys.
+func (m *model) apply(bp *blackhole.Blueprint) {
+	m.applyColumns(bp)
+	m.foreignKeys = append(m.foreignKeys, bp.ForeignKeys()...)
+}
+
+// applyColumns applies the column definitions of the blueprint and its children on the model.
+func (m *model) applyColumns(bp *blackhole.Blueprint) {
+	for _, d := range bp.Definitions() {
+		switch d := d.(type) {
+		case *blackhole.Column:
+			m.fields = append(m.fields, &field{name: d.GetName(), column: d})
+		case *blackhole.ModifyColumn:
+			if f := m.field(d.Column().GetName()); f != nil {
+				f.column = d.Column()
+			}
+		case *blackhole.RenameColumn:
+			if f := m.field(d.From()); f != nil {
+				f.name = d.To()
+			}
+		case *blackhole.DropColumn:
+			m.fields = slices.DeleteFunc(m.fields, func(f *field) bool { return f.name == d.Column() })
+		}
+	}
+	for _, child := range bp.Children() {
+		m.applyColumns(child)
+	}
+}
+
+// field returns the field of the column with the given name, or nil if there is none.
+func (m *model) field(name string) *field {
+	for _, f := range m.fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// foreignKeyOf returns the last foreign key defined on the column, or nil if there is none.
+func (m *model) foreignKeyOf(column string) *blackhole.ForeignKey {
+	for i := len(m.foreignKeys) - 1; i >= 0; i-- {
+		if m.foreignKeys[i].GetColumn() == column {
+			return m.foreignKeys[i]
+		}
+	}
+	return nil
+}
+
+// name returns the Go type name of the model, the singular of its unqualified table name.
+func (m *model) name() string {
+	_, table := blackhole.SplitQualified(m.table)
+	return identifier(pluralize.NewClient().Singular(table))
+}
+
+// writeModel writes the enum types, the struct and the TableName method of the model, records the packages its
+// fields refer to and declares its identifiers.
+func writeModel(b *strings.Builder, m *model, options Options, imports map[string]bool, declared declarations) error {
+	name := m.name()
+	for _, f := range m.fields {
+		if f.column.GetDataType().IsEnum() && f.column.GetEnumValues() != nil {
+			if err := writeEnum(b, name+identifier(f.name), m.table, f, declared); err != nil {
+				return err
+			}
+		}
+	}
+	if err := declared.declare(name, "the "+m.table+" table"); err != nil {
+		return err
+	}
+	members := declarations{"TableName": "the TableName method of " + name}
+
+	fmt.Fprintf(b, "// %s is a row of the %s table.\n", name, m.table)
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, f := range m.fields {
+		if comment := f.column.GetComment(); comment != nil && comment.Get() != "" {
+			fmt.Fprintf(b, "// %s\n", strings.ReplaceAll(comment.Get(), "\n", "\n// "))
+		}
+		if fk := m.foreignKeyOf(f.name); fk != nil {
+			fmt.Fprintf(b, "// %s references %s.\n", identifier(f.name), describeForeignKey(fk))
+		}
+		if err := members.declare(identifier(f.name), "the "+f.name+" column of the "+m.table+" table"); err != nil {
+			return err
+		}
+		typ := fieldType(name, f, options.NullStyle)
+		for prefix, path := range packages {
+			if strings.Contains(typ, prefix) {
+				imports[path] = true
+			}
+		}
+		fmt.Fprintf(b, "%s %s `db:%q json:%q`\n", identifier(f.name), typ, f.name, f.name)
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(b, "// TableName returns the name of the table %s rows are stored in.\n", name)
+	fmt.Fprintf(b, "func (%s) TableName() string {\n\treturn %q\n}\n\n", name, m.table)
+	return nil
+}
+
+// writeEnum writes the typed string and the constants of the values of an enum column, and declares them.
+func writeEnum(b *strings.Builder, typeName, table string, f *field, declared declarations) error {
+	column := "the " + f.name + " column of the " + table + " table"
+	if err := declared.declare(typeName, column); err != nil {
+		return err
+	}
+	fmt.Fprintf(b, "// %s is a value of the %s column of the %s table.\n", typeName, f.name, table)
+	fmt.Fprintf(b, "type %s string\n\n", typeName)
+	b.WriteString("const (\n")
+	for i, value := range f.column.GetEnumValues().Values {
+		constant := typeName + identifier(value)
+		if constant == typeName {
+			constant = typeName + "Value" + strconv.Itoa(i)
+		}
+		if err := declared.declare(constant, fmt.Sprintf("the value %q of %s", value, column)); err != nil {
+			return err
+		}
+		fmt.Fprintf(b, "%s %s = %q\n", constant, typeName, value)
+	}
+	b.WriteString(")\n\n")
+	return nil
+}
+
+// describeForeignKey describes the column a foreign key references and the actions it takes.
+func describeForeignKey(fk *blackhole.ForeignKey) string {
+	table, column := fk.References()
+	description := table + "." + column
+	var actions []string
+	if a := fk.GetOnDeleteAction(); a != nil {
+		actions = append(actions, "on delete "+string(*a))
+	}
+	if a := fk.GetOnUpdateAction(); a != nil {
+		actions = append(actions, "on update "+string(*a))
+	}
+	if len(actions) > 0 {
+		description += " (" + strings.Join(actions, ", ") + ")"
+	}
+	return description
+}
+
+// fieldType returns the Go type of the field of a model.
+func fieldType(modelName string, f *field, style NullStyle) string {
+	typ := baseType(modelName, f)
+	if !isNullable(f.column) || typ == "[]byte" || typ == "json.RawMessage" {
+		return typ
+	}
+	if style == NullSQL {
+		if wrapper, ok := sqlNullTypes[typ]; ok {
+			return wrapper
+		}
+		return "sql.Null[" + typ + "]"
+	}
+	return "*" + typ
+}
+
+// baseType returns the Go type of the values of a column, regardless of it being nullable.
+func baseType(modelName string, f *field) string {
+	c := f.column
+	dataType := c.GetDataType()
+	unsigned := c.IsUnsigned()
+
+	switch dataType {
+	case blackhole.ColumnTypeTinyInt:
+		if c.GetLength() == 1 {
+			return "bool"
+		}
+		return integerType("int8", unsigned)
+	case blackhole.ColumnTypeSmallInt:
+		return integerType("int16", unsigned)
+	case blackhole.ColumnTypeInt, blackhole.ColumnTypeMediumInt:
+		return integerType("int32", unsigned)
+	case blackhole.ColumnTypeBigInt:
+		return integerType("int64", unsigned)
+	case blackhole.ColumnTypeFloat:
+		return "float32"
+	case blackhole.ColumnTypeDouble:
+		return "float64"
+	case blackhole.ColumnTypeDecimal:
+		// Decimals are kept as strings so that no precision is lost.
+		return "string"
+	case blackhole.ColumnTypeYear:
+		return "int16"
+	case blackhole.ColumnTypeTime:
+		return "string"
+	case blackhole.ColumnTypeJson:
+		return "json.RawMessage"
+	case blackhole.ColumnTypeEnum:
+		if c.GetEnumValues() != nil {
+			return modelName + identifier(f.name)
+		}
+		return "string"
+	}
+
+	switch {
+	case dataType.IsString(), dataType == blackhole.ColumnTypeSet:
+		return "string"
+	case dataType.IsTime():
+		return "time.Time"
+	}
+	return "[]byte"
+}
+
+// integerType returns the signed integer type, or its unsigned variant.
+func integerType(signed string, unsigned bool) string {
+	if unsigned {
+		return "u" + signed
+	}
+	return signed
+}
+
+// isNullable returns whether the column may hold NULL. Columns without an explicit nullability are nullable,
+// like they are in the database, unless they are primary, auto incrementing or identity keys.
+func isNullable(c *blackhole.Column) bool {
+	if n := c.GetNullable(); n != nil {
+		return n.Is()
+	}
+	return !c.IsPrimary() && c.GetAutoIncrements() == nil && c.GetIdentity() == nil
+}
+
+// writeImports writes the import block for the given package paths.
+func writeImports(b *strings.Builder, imports map[string]bool) {
+	if len(imports) == 0 {
+		return
+	}
+	paths := slices.Sorted(maps.Keys(imports))
+	b.WriteString("import (\n")
+	for _, path := range paths {
+		fmt.Fprintf(b, "%q\n", path)
+	}
+	b.WriteString(")\n\n")
+}
+
+// identifier converts a snake cased or otherwise separated name, such as "user_id" or "in-progress",
+// to an exported Go identifier ("UserID", "InProgress").
+func identifier(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, word := range words {
+		if initialisms[strings.ToLower(word)] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	id := b.String()
+	if id != "" && unicode.IsDigit([]rune(id)[0]) {
+		id = "X" + id
+	}
+	return id
+}
@@ -0,0 +1,244 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/uutkukorkmaz/blackhole"
+)
+
+func testSchema() *blackhole.Schema {
+	schema := blackhole.NewSchema(blackhole.MySQL)
+	schema.Create("users", func(bp *blackhole.Blueprint) {
+		bp.Id()
+		bp.String("email", 191).NotNull()
+		bp.Enum("status", []string{"active", "in-progress"}).Default("active")
+		bp.Text("bio").AddComment("Shown on the profile.")
+		bp.Timestamps()
+	})
+	schema.Create("blog_posts", func(bp *blackhole.Blueprint) {
+		bp.Id()
+		foreign, authorId := bp.ForeignId("author_id")
+		foreign.On("users", "id").CascadeOnDelete()
+		authorId.NotNull()
+		bp.Boolean("published").NotNull()
+		bp.Enum("visibility", []string{"active", "hidden"}).NotNull()
+		bp.Double("rating")
+		bp.Binary("checksum")
+	})
+	schema.Alter("users", func(bp *blackhole.Blueprint) {
+		bp.RenameColumn("bio", "about")
+		bp.Int("age").Unsigned()
+	})
+	schema.Create("sessions", func(bp *blackhole.Blueprint) {
+		bp.Id()
+	})
+	schema.Drop("sessions")
+	return schema
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  Options
+		expected string
+	}{
+		{
+			name:    "pointer nulls",
+			options: Options{},
+			expected: `// Code generated by blackhole/gen. DO NOT EDIT.
+
+package models
+
+import (
+	"time"
+)
+
+// UserStatus is a value of the status column of the users table.
+type UserStatus string
+
+const (
+	UserStatusActive     UserStatus = "active"
+	UserStatusInProgress UserStatus = "in-progress"
+)
+
+// User is a row of the users table.
+type User struct {
+	ID     uint64     ` + "`db:\"id\" json:\"id\"`" + `
+	Email  string     ` + "`db:\"email\" json:\"email\"`" + `
+	Status UserStatus ` + "`db:\"status\" json:\"status\"`" + `
+	// Shown on the profile.
+	About     *string   ` + "`db:\"about\" json:\"about\"`" + `
+	CreatedAt time.Time ` + "`db:\"created_at\" json:\"created_at\"`" + `
+	UpdatedAt time.Time ` + "`db:\"updated_at\" json:\"updated_at\"`" + `
+	Age       *uint32   ` + "`db:\"age\" json:\"age\"`" + `
+}
+
+// TableName returns the name of the table User rows are stored in.
+func (User) TableName() string {
+	return "users"
+}
+
+// BlogPostVisibility is a value of the visibility column of the blog_posts table.
+type BlogPostVisibility string
+
+const (
+	BlogPostVisibilityActive BlogPostVisibility = "active"
+	BlogPostVisibilityHidden BlogPostVisibility = "hidden"
+)
+
+// BlogPost is a row of the blog_posts table.
+type BlogPost struct {
+	ID uint64 ` + "`db:\"id\" json:\"id\"`" + `
+	// AuthorID references users.id (on delete cascade).
+	AuthorID   uint64             ` + "`db:\"author_id\" json:\"author_id\"`" + `
+	Published  bool               ` + "`db:\"published\" json:\"published\"`" + `
+	Visibility BlogPostVisibility ` + "`db:\"visibility\" json:\"visibility\"`" + `
+	Rating     *float64           ` + "`db:\"rating\" json:\"rating\"`" + `
+	Checksum   []byte             ` + "`db:\"checksum\" json:\"checksum\"`" + `
+}
+
+// TableName returns the name of the table BlogPost rows are stored in.
+func (BlogPost) TableName() string {
+	return "blog_posts"
+}
+`,
+		},
+		{
+			name:    "sql nulls",
+			options: Options{Package: "store", NullStyle: NullSQL},
+			expected: `// Code generated by blackhole/gen. DO NOT EDIT.
+
+package store
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserStatus is a value of the status column of the users table.
+type UserStatus string
+
+const (
+	UserStatusActive     UserStatus = "active"
+	UserStatusInProgress UserStatus = "in-progress"
+)
+
+// User is a row of the users table.
+type User struct {
+	ID     uint64     ` + "`db:\"id\" json:\"id\"`" + `
+	Email  string     ` + "`db:\"email\" json:\"email\"`" + `
+	Status UserStatus ` + "`db:\"status\" json:\"status\"`" + `
+	// Shown on the profile.
+	About     sql.NullString   ` + "`db:\"about\" json:\"about\"`" + `
+	CreatedAt time.Time        ` + "`db:\"created_at\" json:\"created_at\"`" + `
+	UpdatedAt time.Time        ` + "`db:\"updated_at\" json:\"updated_at\"`" + `
+	Age       sql.Null[uint32] ` + "`db:\"age\" json:\"age\"`" + `
+}
+
+// TableName returns the name of the table User rows are stored in.
+func (User) TableName() string {
+	return "users"
+}
+
+// BlogPostVisibility is a value of the visibility column of the blog_posts table.
+type BlogPostVisibility string
+
+const (
+	BlogPostVisibilityActive BlogPostVisibility = "active"
+	BlogPostVisibilityHidden BlogPostVisibility = "hidden"
+)
+
+// BlogPost is a row of the blog_posts table.
+type BlogPost struct {
+	ID uint64 ` + "`db:\"id\" json:\"id\"`" + `
+	// AuthorID references users.id (on delete cascade).
+	AuthorID   uint64             ` + "`db:\"author_id\" json:\"author_id\"`" + `
+	Published  bool               ` + "`db:\"published\" json:\"published\"`" + `
+	Visibility BlogPostVisibility ` + "`db:\"visibility\" json:\"visibility\"`" + `
+	Rating     sql.NullFloat64    ` + "`db:\"rating\" json:\"rating\"`" + `
+	Checksum   []byte             ` + "`db:\"checksum\" json:\"checksum\"`" + `
+}
+
+// TableName returns the name of the table BlogPost rows are stored in.
+func (BlogPost) TableName() string {
+	return "blog_posts"
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := Generate(testSchema(), tt.options)
+			if err != nil {
+				t.Fatalf("Error: %s", err)
+			}
+			if string(source) != tt.expected {
+				t.Errorf("Expected: %s", tt.expected)
+				t.Errorf("Got: %s", source)
+			}
+		})
+	}
+}
+
+func TestGenerate_Collisions(t *testing.T) {
+	tests := map[string]struct {
+		build    func(schema *blackhole.Schema)
+		expected string
+	}{
+		"enum values": {
+			build: func(schema *blackhole.Schema) {
+				schema.Create("users", func(bp *blackhole.Blueprint) {
+					bp.Enum("status", []string{"in-progress", "in_progress"})
+				})
+			},
+			expected: `UserStatusInProgress would be declared for both the value "in-progress" of the status column of the users table and the value "in_progress" of the status column of the users table`,
+		},
+		"enum type and model": {
+			build: func(schema *blackhole.Schema) {
+				schema.Create("users", func(bp *blackhole.Blueprint) {
+					bp.Enum("status", []string{"active"})
+				})
+				schema.Create("user_statuses", func(bp *blackhole.Blueprint) {
+					bp.Id()
+				})
+			},
+			expected: "UserStatus would be declared for both the status column of the users table and the user_statuses table",
+		},
+		"fields": {
+			build: func(schema *blackhole.Schema) {
+				schema.Create("users", func(bp *blackhole.Blueprint) {
+					bp.Int("table_name")
+				})
+			},
+			expected: "TableName would be declared for both the TableName method of User and the table_name column of the users table",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			schema := blackhole.NewSchema(blackhole.MySQL)
+			tt.build(schema)
+			if _, err := Generate(schema, Options{}); err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected: %s", tt.expected)
+				t.Errorf("Got: %v", err)
+			}
+		})
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"user_id":     "UserID",
+		"api_key":     "APIKey",
+		"in-progress": "InProgress",
+		"2fa_enabled": "X2faEnabled",
+		"createdAt":   "CreatedAt",
+	}
+	for name, expected := range tests {
+		if got := identifier(name); got != expected {
+			t.Errorf("identifier(%q): expected %s, got %s", name, expected, got)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package blackhole
+
+// Generated represents the expression a generated column is computed from.
+type Generated struct {
+	Definition
+	expression string
+	stored     bool
+}
+
+// NewGenerated creates a new generated column expression, either stored or virtual.
+func NewGenerated(expression string, stored bool) *Generated {
+	return &Generated{
+		expression: expression,
+		stored:     stored,
+	}
+}
+
+// Get returns the expression the column is generated from.
+func (g *Generated) Get() string {
+	return g.expression
+}
+
+// IsStored returns whether the generated value is stored rather than computed on read.
+func (g *Generated) IsStored() bool {
+	return g.stored
+}
+
+func (g *Generated) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileGenerated(g)
+}
@@ -5,29 +5,71 @@ import (
 )
 
 type Grammar interface {
+	Dialect() Dialect
 	GetDefaultCollation() (string, error)
 	GetDefaultCharset() (string, error)
-	CompileCreateDatabase(database string) (string, error)
-	CompileDropDatabase(database string) (string, error)
+	CompileCreateDatabase(database *Database) (string, error)
+	CompileDropDatabase(database *Database) (string, error)
+	CompileCreateNamespace(namespace *Namespace) (string, error)
+	CompileDropNamespace(namespace *Namespace) (string, error)
+	CompileCreateEnumType(enumType *EnumType) (string, error)
+	CompileAddEnumTypeValue(enumType *EnumType, value string) (string, error)
+	CompileDropEnumType(enumType *EnumType) (string, error)
+	CompileCreateSequence(sequence *Sequence) (string, error)
+	CompileAlterSequence(sequence *Sequence) (string, error)
+	CompileDropSequence(sequence *Sequence) (string, error)
 	CompileCreateTable(table Blueprint) (string, error)
 	CompileAlterTable(table Blueprint) (string, error)
 	CompileDropTable(table Blueprint) (string, error)
+	CompileRenameTable(table Blueprint) (string, error)
+	CompileTruncateTable(table Blueprint) (string, error)
+	CompileTableOptions(options TableOptions) (string, error)
 	GetDateFormat() string
 	CompileColumn(c *Column) (string, error)
 	CompileAutoIncrement(a *AutoIncrements) (string, error)
+	CompileIdentity(i *Identity) (string, error)
 	CompileDefaultValue(d *DefaultValue) (string, error)
 	CompileComment(c *Comment) (string, error)
 	CompileNullable(n *Nullable) (string, error)
+	CompileGenerated(g *Generated) (string, error)
 	CompileEnumValues(e *EnumValues) (string, error)
 	CompileIndex(i *Index) (string, error)
 	Build(b *Blueprint) (string, error)
 	CompileForeignKey(f *ForeignKey) (string, error)
 	CompileRenameColumn(r *RenameColumn) (string, error)
 	CompileDropColumn(column string) (string, error)
+	CompileModifyColumn(m *ModifyColumn) (string, error)
+	CompileCheck(c *Check) (string, error)
+	CompileCreateView(v *View) (string, error)
+	CompileDropView(v *View) (string, error)
+	CompileCreateMaterializedView(v *View) (string, error)
+	CompileRefreshMaterializedView(v *View) (string, error)
+	CompileCreateTrigger(t *Trigger) (string, error)
+	CompileDropTrigger(t *Trigger) (string, error)
+	CompileCreateRoutine(r *Routine) (string, error)
+	CompileDropRoutine(r *Routine) (string, error)
+	CompileCompoundStatement(sql string) (string, error)
+	CompilePartitioning(p *Partitioning) (string, error)
+	CompileAddPartition(a *AddPartition) (string, error)
+	CompileDropPartition(d *DropPartition) (string, error)
+	CompileReorganizePartition(r *ReorganizePartition) (string, error)
+	CompileDropCheck(name string) (string, error)
+	WrapTable(table string) (string, error)
+	CompileTableListing() (string, error)
+	CompileViewListing() (string, error)
+	CompileTableDefinition(table string) (string, error)
+	CompileViewDefinition(view string) (string, error)
+	CompileDisableForeignKeyConstraints() (string, error)
+	CompileEnableForeignKeyConstraints() (string, error)
 }
 
 type baseGrammar struct{}
 
+// Dialect returns the SQL dialect of the grammar. The base grammar has no dialect of its own.
+func (bg *baseGrammar) Dialect() Dialect {
+	return ""
+}
+
 // GetDefaultCollation provides a default collation, which can be overridden.
 func (bg *baseGrammar) GetDefaultCollation() (string, error) {
 	return "utf8mb4_unicode_ci", nil
@@ -39,15 +81,55 @@ func (bg *baseGrammar) GetDefaultCharset() (string, error) {
 }
 
 // CompileCreateDatabase returns a basic SQL statement for creating a database.
-func (bg *baseGrammar) CompileCreateDatabase(_ string) (string, error) {
+func (bg *baseGrammar) CompileCreateDatabase(_ *Database) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileCreateDatabase not implemented")
 }
 
 // CompileDropDatabase returns a basic SQL statement for dropping a database.
-func (bg *baseGrammar) CompileDropDatabase(_ string) (string, error) {
+func (bg *baseGrammar) CompileDropDatabase(_ *Database) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileDropDatabase not implemented")
 }
 
+// CompileCreateNamespace is a placeholder for creating namespaces.
+func (bg *baseGrammar) CompileCreateNamespace(_ *Namespace) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCreateNamespace not implemented")
+}
+
+// CompileDropNamespace is a placeholder for dropping namespaces.
+func (bg *baseGrammar) CompileDropNamespace(_ *Namespace) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropNamespace not implemented")
+}
+
+// CompileCreateEnumType is a placeholder for creating enum types.
+func (bg *baseGrammar) CompileCreateEnumType(_ *EnumType) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCreateEnumType not implemented")
+}
+
+// CompileAddEnumTypeValue is a placeholder for adding a value to an enum type.
+func (bg *baseGrammar) CompileAddEnumTypeValue(_ *EnumType, _ string) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileAddEnumTypeValue not implemented")
+}
+
+// CompileDropEnumType is a placeholder for dropping enum types.
+func (bg *baseGrammar) CompileDropEnumType(_ *EnumType) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropEnumType not implemented")
+}
+
+// CompileCreateSequence is a placeholder for creating sequences.
+func (bg *baseGrammar) CompileCreateSequence(_ *Sequence) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCreateSequence not implemented")
+}
+
+// CompileAlterSequence is a placeholder for altering sequences.
+func (bg *baseGrammar) CompileAlterSequence(_ *Sequence) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileAlterSequence not implemented")
+}
+
+// CompileDropSequence is a placeholder for dropping sequences.
+func (bg *baseGrammar) CompileDropSequence(_ *Sequence) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropSequence not implemented")
+}
+
 // CompileCreateTable is a placeholder, expecting the table creation logic to be implemented by specific grammars.
 func (bg *baseGrammar) CompileCreateTable(_ Blueprint) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileCreateTable not implemented")
@@ -63,6 +145,21 @@ func (bg *baseGrammar) CompileDropTable(_ Blueprint) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileDropTable not implemented")
 }
 
+// CompileRenameTable is a placeholder, expecting the table rename logic to be implemented by specific grammars.
+func (bg *baseGrammar) CompileRenameTable(_ Blueprint) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileRenameTable not implemented")
+}
+
+// CompileTruncateTable is a placeholder, expecting the table truncation logic to be implemented by specific grammars.
+func (bg *baseGrammar) CompileTruncateTable(_ Blueprint) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileTruncateTable not implemented")
+}
+
+// CompileTableOptions is a placeholder, expecting table options to be handled by specific grammars.
+func (bg *baseGrammar) CompileTableOptions(_ TableOptions) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileTableOptions not implemented")
+}
+
 // DefineColumn is a placeholder, expecting column definitions to be handled by specific grammars.
 func (bg *baseGrammar) CompileColumn(_ *Column) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileColumn not implemented")
@@ -83,11 +180,21 @@ func (bg *baseGrammar) CompileComment(_ *Comment) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileComment not implemented")
 }
 
+// CompileIdentity is a placeholder for identity columns.
+func (bg *baseGrammar) CompileIdentity(_ *Identity) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileIdentity not implemented")
+}
+
 // CompileNullable handles nullable constraints for a column.
 func (bg *baseGrammar) CompileNullable(_ *Nullable) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileNullable not implemented")
 }
 
+// CompileGenerated handles the expression of a generated column.
+func (bg *baseGrammar) CompileGenerated(_ *Generated) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileGenerated not implemented")
+}
+
 // CompileEnumValues compiles the list of enum values.
 func (bg *baseGrammar) CompileEnumValues(_ *EnumValues) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileEnumValues not implemented")
@@ -122,3 +229,118 @@ func (bg *baseGrammar) CompileRenameColumn(_ *RenameColumn) (string, error) {
 func (bg *baseGrammar) CompileDropColumn(_ string) (string, error) {
 	return "", fmt.Errorf("blackhole: CompileDropColumn not implemented")
 }
+
+// CompileModifyColumn is a placeholder for changing column definitions.
+func (bg *baseGrammar) CompileModifyColumn(_ *ModifyColumn) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileModifyColumn not implemented")
+}
+
+// CompileCheck is a placeholder for check constraint handling.
+func (bg *baseGrammar) CompileCheck(_ *Check) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCheck not implemented")
+}
+
+// CompileDropCheck is a placeholder for dropping check constraints.
+func (bg *baseGrammar) CompileDropCheck(_ string) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropCheck not implemented")
+}
+
+// CompilePartitioning is a placeholder for table partitioning handling.
+func (bg *baseGrammar) CompilePartitioning(_ *Partitioning) (string, error) {
+	return "", fmt.Errorf("blackhole: CompilePartitioning not implemented")
+}
+
+// CompileAddPartition is a placeholder for adding partitions.
+func (bg *baseGrammar) CompileAddPartition(_ *AddPartition) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileAddPartition not implemented")
+}
+
+// CompileDropPartition is a placeholder for dropping partitions.
+func (bg *baseGrammar) CompileDropPartition(_ *DropPartition) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropPartition not implemented")
+}
+
+// CompileReorganizePartition is a placeholder for reorganizing partitions.
+func (bg *baseGrammar) CompileReorganizePartition(_ *ReorganizePartition) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileReorganizePartition not implemented")
+}
+
+// CompileCreateView is a placeholder for creating views.
+func (bg *baseGrammar) CompileCreateView(_ *View) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCreateView not implemented")
+}
+
+// CompileDropView is a placeholder for dropping views.
+func (bg *baseGrammar) CompileDropView(_ *View) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropView not implemented")
+}
+
+// CompileCreateMaterializedView is a placeholder for creating materialized views.
+func (bg *baseGrammar) CompileCreateMaterializedView(_ *View) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCreateMaterializedView not implemented")
+}
+
+// CompileRefreshMaterializedView is a placeholder for refreshing materialized views.
+func (bg *baseGrammar) CompileRefreshMaterializedView(_ *View) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileRefreshMaterializedView not implemented")
+}
+
+// CompileCreateTrigger is a placeholder for creating triggers.
+func (bg *baseGrammar) CompileCreateTrigger(_ *Trigger) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCreateTrigger not implemented")
+}
+
+// CompileDropTrigger is a placeholder for dropping triggers.
+func (bg *baseGrammar) CompileDropTrigger(_ *Trigger) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropTrigger not implemented")
+}
+
+// CompileCreateRoutine is a placeholder for creating stored procedures and functions.
+func (bg *baseGrammar) CompileCreateRoutine(_ *Routine) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCreateRoutine not implemented")
+}
+
+// CompileDropRoutine is a placeholder for dropping stored procedures and functions.
+func (bg *baseGrammar) CompileDropRoutine(_ *Routine) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDropRoutine not implemented")
+}
+
+// CompileCompoundStatement is a placeholder for delimiting statements that hold statement terminators of their own.
+func (bg *baseGrammar) CompileCompoundStatement(_ string) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileCompoundStatement not implemented")
+}
+
+// CompileTableListing is a placeholder for listing the tables of the current database.
+func (bg *baseGrammar) CompileTableListing() (string, error) {
+	return "", fmt.Errorf("blackhole: CompileTableListing not implemented")
+}
+
+// WrapTable is a placeholder for quoting a table name.
+func (bg *baseGrammar) WrapTable(_ string) (string, error) {
+	return "", fmt.Errorf("blackhole: WrapTable not implemented")
+}
+
+// CompileViewListing is a placeholder for listing the views of the current database.
+func (bg *baseGrammar) CompileViewListing() (string, error) {
+	return "", fmt.Errorf("blackhole: CompileViewListing not implemented")
+}
+
+// CompileTableDefinition is a placeholder for querying the create statement of a table.
+func (bg *baseGrammar) CompileTableDefinition(_ string) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileTableDefinition not implemented")
+}
+
+// CompileViewDefinition is a placeholder for querying the create statement of a view.
+func (bg *baseGrammar) CompileViewDefinition(_ string) (string, error) {
+	return "", fmt.Errorf("blackhole: CompileViewDefinition not implemented")
+}
+
+// CompileDisableForeignKeyConstraints is a placeholder for disabling foreign key checks.
+func (bg *baseGrammar) CompileDisableForeignKeyConstraints() (string, error) {
+	return "", fmt.Errorf("blackhole: CompileDisableForeignKeyConstraints not implemented")
+}
+
+// CompileEnableForeignKeyConstraints is a placeholder for enabling foreign key checks.
+func (bg *baseGrammar) CompileEnableForeignKeyConstraints() (string, error) {
+	return "", fmt.Errorf("blackhole: CompileEnableForeignKeyConstraints not implemented")
+}
@@ -10,13 +10,13 @@ type dummyGrammar struct {
 }
 
 // CompileCreateDatabase provides a dummy implementation for creating a database.
-func (dg *dummyGrammar) CompileCreateDatabase(name string) (string, error) {
-	return fmt.Sprintf("DUMMY CREATE DATABASE %s;", name), nil
+func (dg *dummyGrammar) CompileCreateDatabase(database *Database) (string, error) {
+	return fmt.Sprintf("DUMMY CREATE DATABASE %s;", database.GetName()), nil
 }
 
 // CompileDropDatabase provides a dummy implementation for dropping a database.
-func (dg *dummyGrammar) CompileDropDatabase(name string) (string, error) {
-	return fmt.Sprintf("DUMMY DROP DATABASE %s;", name), nil
+func (dg *dummyGrammar) CompileDropDatabase(database *Database) (string, error) {
+	return fmt.Sprintf("DUMMY DROP DATABASE %s;", database.GetName()), nil
 }
 
 // CompileCreateTable provides a dummy implementation for creating a table.
@@ -32,10 +32,10 @@ func (dg *dummyGrammar) CompileColumn(c *Column) (string, error) {
 // Unit tests for dummyGrammar
 func TestDummyGrammar_CompileCreateDatabase(t *testing.T) {
 	grammar := &dummyGrammar{}
-	dbName := "test_db"
+	db := NewDatabase("test_db", DatabaseOptions{})
 	expected := "DUMMY CREATE DATABASE test_db;"
 
-	result, err := grammar.CompileCreateDatabase(dbName)
+	result, err := grammar.CompileCreateDatabase(db)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -47,10 +47,10 @@ func TestDummyGrammar_CompileCreateDatabase(t *testing.T) {
 
 func TestDummyGrammar_CompileDropDatabase(t *testing.T) {
 	grammar := &dummyGrammar{}
-	dbName := "test_db"
+	db := NewDatabase("test_db", DatabaseOptions{})
 	expected := "DUMMY DROP DATABASE test_db;"
 
-	result, err := grammar.CompileDropDatabase(dbName)
+	result, err := grammar.CompileDropDatabase(db)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
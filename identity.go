@@ -0,0 +1,32 @@
+package blackhole
+
+// Identity represents an identity column, whose values the database generates from an implicit sequence.
+// Grammars with identity columns compile Id() as generated by default as identity rather than auto increment.
+type Identity struct {
+	Definition
+	always  bool
+	options SequenceOptions
+}
+
+// NewIdentity creates a new identity with the options of its sequence. An identity generated always rejects
+// explicit values, one generated by default only fills in missing values.
+func NewIdentity(always bool, options SequenceOptions) *Identity {
+	return &Identity{
+		always:  always,
+		options: options,
+	}
+}
+
+// IsAlways returns whether the identity is generated always rather than by default.
+func (i *Identity) IsAlways() bool {
+	return i.always
+}
+
+// GetOptions returns the options of the identity's sequence.
+func (i *Identity) GetOptions() SequenceOptions {
+	return i.options
+}
+
+func (i *Identity) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileIdentity(i)
+}
@@ -1,9 +1,14 @@
 package blackhole
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 type IndexType string
 type IndexAlgorithm string
+type IndexOrder string
+type IndexVisibility string
 
 const (
 	IndexTypePrimary  IndexType = "primary"
@@ -19,27 +24,132 @@ const (
 	IndexAlgorithmHash    IndexAlgorithm = "hash"
 )
 
+const (
+	IndexOrderAsc  IndexOrder = "asc"
+	IndexOrderDesc IndexOrder = "desc"
+)
+
+const (
+	IndexVisibilityDefault IndexVisibility = ""
+	IndexVisible           IndexVisibility = "visible"
+	IndexInvisible         IndexVisibility = "invisible"
+)
+
 type Index struct {
 	Definition
-	Table     string
-	Type      IndexType
-	Columns   []string
-	Algorithm IndexAlgorithm
+	Name       string
+	Table      string
+	Type       IndexType
+	Columns    []string
+	Algorithm  IndexAlgorithm
+	Parser     string
+	Language   string
+	Lengths    map[string]int
+	Orders     map[string]IndexOrder
+	Comment    *Comment
+	Visibility IndexVisibility
+	// Expressions are key parts computed from an expression, indexed after the plain columns.
+	Expressions []string
+	// Condition is the predicate of a partial index.
+	Condition string
 }
 
 func (i *Index) ColumnsString() string {
 	s := make([]string, len(i.Columns))
 	for k, v := range i.Columns {
 		s[k] = "`" + v + "`"
+		if length := i.Lengths[v]; length > 0 {
+			s[k] += "(" + strconv.Itoa(length) + ")"
+		}
+		if order, ok := i.Orders[v]; ok {
+			s[k] += " " + string(order)
+		}
 	}
 	return strings.Join(s, ", ")
 }
 
+// WithLength sets the prefix length of the given column in the index.
+func (i *Index) WithLength(column string, length int) *Index {
+	if i.Lengths == nil {
+		i.Lengths = map[string]int{}
+	}
+	i.Lengths[column] = length
+	return i
+}
+
+// Asc sets the given column to be indexed in ascending order.
+func (i *Index) Asc(column string) *Index {
+	return i.setOrder(column, IndexOrderAsc)
+}
+
+// Desc sets the given column to be indexed in descending order.
+func (i *Index) Desc(column string) *Index {
+	return i.setOrder(column, IndexOrderDesc)
+}
+
+// setOrder sets the order of the given column in the index.
+func (i *Index) setOrder(column string, order IndexOrder) *Index {
+	if i.Orders == nil {
+		i.Orders = map[string]IndexOrder{}
+	}
+	i.Orders[column] = order
+	return i
+}
+
+// WithComment adds a comment to the index.
+func (i *Index) WithComment(comment string) *Index {
+	i.Comment = NewComment(comment)
+	return i
+}
+
+// Invisible makes the index invisible to the query optimizer.
+func (i *Index) Invisible() *Index {
+	i.Visibility = IndexInvisible
+	return i
+}
+
+// Visible makes the index visible to the query optimizer.
+func (i *Index) Visible() *Index {
+	i.Visibility = IndexVisible
+	return i
+}
+
 func (i *Index) Using(algorithm IndexAlgorithm) *Index {
 	i.Algorithm = algorithm
 	return i
 }
 
+// WithParser sets the full-text parser plugin of the index, e.g. "ngram".
+func (i *Index) WithParser(parser string) *Index {
+	i.Parser = parser
+	return i
+}
+
+// WithLanguage sets the text search language of a full-text index. Grammars without text search languages,
+// such as MySQL, fail the build with ErrNotSupported.
+func (i *Index) WithLanguage(language string) *Index {
+	i.Language = language
+	return i
+}
+
+// Named sets an explicit name for the index instead of the one derived from its columns.
+func (i *Index) Named(name string) *Index {
+	i.Name = name
+	return i
+}
+
+// Expr adds an expression key part, such as "lower(email)", to the index.
+func (i *Index) Expr(expression string) *Index {
+	i.Expressions = append(i.Expressions, expression)
+	return i
+}
+
+// Where restricts the index to the rows matching the predicate, making it a partial index.
+func (i *Index) Where(condition string) *Index {
+	i.Condition = condition
+	return i
+}
+
 func (i *Index) Expression(grammar Grammar) (string, error) {
 	return grammar.CompileIndex(i)
 }
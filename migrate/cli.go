@@ -0,0 +1,225 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+)
+
+const usage = `Usage: blackhole <command> [flags] [arguments]
+
+Commands:
+  make:migration <name>  create a new migration, e.g. create_users_table
+  migrate                run the pending migrations
+  migrate:rollback       roll back the last batch of migrations, or the last -step batches
+  migrate:status         show which migrations have run, as a table or with -format json
+  migrate:fresh          drop all views and tables and run every migration
+  schema:dump            dump the database schema to -path, <dir>/schema.sql by default
+  make:models            generate Go models of the tables the migrations create to -path, models_gen.go by default,
+                         in -package ($GOPACKAGE or models) with -null pointer or sql fields for nullable columns
+
+Flags:
+  -env file     the env file to read, .env by default
+  -driver name  the database/sql driver (DB_DRIVER, mysql by default)
+  -dsn dsn      the data source name of the database (DB_DSN)
+  -dir dir      the directory of the migrations package (MIGRATIONS_DIR, migrations by default)
+  -table name   the migrations table (MIGRATIONS_TABLE, migrations by default)
+`
+
+// Options holds a parsed migration command line.
+type Options struct {
+	Command string
+	Args    []string
+	Config  Config
+	Step    int
+	Path    string
+	Format  string
+	Package string
+	Null    string
+}
+
+// ParseArgs parses a migration command line: the command, followed by its arguments and flags in any order.
+// Flags override the configuration loaded from the environment and the env file.
+func ParseArgs(args []string) (Options, error) {
+	if len(args) == 0 {
+		return Options{Command: "help"}, nil
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	envFile := flags.String("env", "", "")
+	driver := flags.String("driver", "", "")
+	dsn := flags.String("dsn", "", "")
+	dir := flags.String("dir", "", "")
+	table := flags.String("table", "", "")
+	step := flags.Int("step", 1, "")
+	path := flags.String("path", "", "")
+	format := flags.String("format", "table", "")
+	pkg := flags.String("package", os.Getenv("GOPACKAGE"), "")
+	null := flags.String("null", "pointer", "")
+
+	options := Options{Command: args[0]}
+	rest := args[1:]
+	for {
+		if err := flags.Parse(rest); err != nil {
+			return Options{}, fmt.Errorf("blackhole: migrate: %w", err)
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		options.Args = append(options.Args, flags.Arg(0))
+		rest = flags.Args()[1:]
+	}
+
+	config, err := LoadConfig(*envFile)
+	if err != nil {
+		return Options{}, err
+	}
+	if *driver != "" {
+		config.Driver = *driver
+	}
+	if *dsn != "" {
+		config.DSN = *dsn
+	}
+	if *dir != "" {
+		config.Dir = *dir
+	}
+	if *table != "" {
+		config.Table = *table
+	}
+	options.Config = config
+	options.Step = *step
+	options.Path = *path
+	options.Format = *format
+	options.Package = *pkg
+	options.Null = *null
+	if options.Format != "table" && options.Format != "json" {
+		return Options{}, fmt.Errorf("blackhole: migrate: invalid -format %q, expected table or json", options.Format)
+	}
+	if options.Path == "" && options.Command == "make:models" {
+		options.Path = "models_gen.go"
+	} else if options.Path == "" {
+		options.Path = filepath.Join(config.Dir, "schema.sql")
+	}
+	return options, nil
+}
+
+// Main runs the migration command line with the migrations registered through Register, and exits the program
+// on failure. It is the body of the main.go that make:migration writes to the migrations package.
+func Main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := Run(ctx, os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+// Run runs a migration command with the migrations registered through Register, writing its output to stdout.
+func Run(ctx context.Context, args []string, stdout io.Writer) error {
+	options, err := ParseArgs(args)
+	if errors.Is(err, flag.ErrHelp) {
+		options.Command = "help"
+	} else if err != nil {
+		return err
+	}
+
+	switch options.Command {
+	case "help", "-h", "-help", "--help":
+		_, err := io.WriteString(stdout, usage)
+		return err
+	case "make:migration":
+		if len(options.Args) != 1 {
+			return fmt.Errorf("blackhole: migrate: make:migration expects a single migration name")
+		}
+		path, err := MakeMigration(options.Config.Dir, options.Args[0], time.Now())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Created migration %s\n", path)
+		return nil
+	case "make:models":
+		return makeModels(options, stdout)
+	case "migrate", "migrate:rollback", "migrate:status", "migrate:fresh", "schema:dump":
+	default:
+		return fmt.Errorf("blackhole: migrate: unknown command %q, see the help command", options.Command)
+	}
+
+	db, migrator, err := open(options.Config)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	switch options.Command {
+	case "migrate":
+		migrated, err := migrator.Migrate(ctx)
+		return report(stdout, "Migrated", "Nothing to migrate.", migrated, err)
+	case "migrate:rollback":
+		rolledBack, err := migrator.Rollback(ctx, options.Step)
+		return report(stdout, "Rolled back", "Nothing to roll back.", rolledBack, err)
+	case "migrate:fresh":
+		migrated, err := migrator.Fresh(ctx)
+		return report(stdout, "Migrated", "Nothing to migrate.", migrated, err)
+	case "migrate:status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		if options.Format == "json" {
+			return WriteStatusJSON(stdout, statuses)
+		}
+		return WriteStatusTable(stdout, statuses)
+	default:
+		file, err := os.Create(options.Path)
+		if err != nil {
+			return fmt.Errorf("blackhole: migrate: %w", err)
+		}
+		if err := migrator.Dump(ctx, file); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("blackhole: migrate: %w", err)
+		}
+		fmt.Fprintf(stdout, "Dumped the schema to %s\n", options.Path)
+		return nil
+	}
+}
+
+// open opens the configured database and returns a migrator for the registered migrations on it.
+func open(config Config) (*sql.DB, *Migrator, error) {
+	grammar, err := config.Grammar()
+	if err != nil {
+		return nil, nil, err
+	}
+	if config.DSN == "" {
+		return nil, nil, fmt.Errorf("blackhole: migrate: no database configured, set DB_DSN or pass -dsn")
+	}
+	db, err := sql.Open(config.Driver, config.DSN)
+	if err != nil {
+		return nil, nil, fmt.Errorf("blackhole: migrate: %w; import the driver in the main.go of the migrations package", err)
+	}
+	return db, NewMigrator(db, grammar, Registered()).Table(config.Table), nil
+}
+
+// report writes a line for every migration that was handled before err, if any, or the message for none,
+// and returns err.
+func report(w io.Writer, verb, none string, names []string, err error) error {
+	if len(names) == 0 && err == nil {
+		fmt.Fprintln(w, none)
+	}
+	for _, name := range names {
+		fmt.Fprintf(w, "%s: %s\n", verb, name)
+	}
+	return err
+}
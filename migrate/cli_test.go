@@ -0,0 +1,142 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uutkukorkmaz/blackhole"
+	"github.com/uutkukorkmaz/blackhole/gen"
+)
+
+func TestParseArgs(t *testing.T) {
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, "test.env")
+	env := "# database\nDB_DSN=\"user:secret@/app\"\nexport MIGRATIONS_DIR=db/migrations\n"
+	if err := os.WriteFile(envFile, []byte(env), 0o644); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	t.Setenv("MIGRATIONS_TABLE", "schema_migrations")
+
+	options, err := ParseArgs([]string{"migrate:rollback", "-env", envFile, "-step", "2", "-driver", "mysql"})
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	expected := Config{Driver: "mysql", DSN: "user:secret@/app", Dir: "db/migrations", Table: "schema_migrations"}
+	if options.Command != "migrate:rollback" || options.Step != 2 || options.Config != expected {
+		t.Errorf("Unexpected options: %+v", options)
+	}
+	if options.Path != filepath.Join("db/migrations", "schema.sql") {
+		t.Errorf("Unexpected dump path: %s", options.Path)
+	}
+
+	options, err = ParseArgs([]string{"make:migration", "-dir", "db", "create_users_table", "-dsn", "other"})
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if len(options.Args) != 1 || options.Args[0] != "create_users_table" || options.Config.Dir != "db" || options.Config.DSN != "other" {
+		t.Errorf("Unexpected options: %+v", options)
+	}
+
+	if _, err := ParseArgs([]string{"migrate:status", "-format", "yaml"}); err == nil {
+		t.Errorf("Expected an error for an invalid format")
+	}
+
+	if _, err := ParseArgs([]string{"migrate", "-env", filepath.Join(dir, "missing.env")}); err == nil {
+		t.Errorf("Expected an error for a missing env file")
+	}
+}
+
+func TestMakeMigration(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "migrations")
+	now := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+
+	path, err := MakeMigration(dir, "create_users_table", now)
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if filepath.Base(path) != "20261018123000_create_users_table.go" {
+		t.Errorf("Unexpected path: %s", path)
+	}
+	source, _ := os.ReadFile(path)
+	for _, expected := range []string{
+		`migrate.Register("20261018123000_create_users_table",`,
+		`schema.Create("users", func(bp *blackhole.Blueprint) {`,
+		`schema.DropIfExists("users")`,
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Errorf("Expected the migration to contain %s, got: %s", expected, source)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "main.go")); err != nil {
+		t.Errorf("Expected main.go to be written: %s", err)
+	}
+
+	path, err = MakeMigration(dir, "add_votes_to_users_table", now)
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if filepath.Base(path) != "20261018123001_add_votes_to_users_table.go" {
+		t.Errorf("Expected the migration to be made after the previous one, got: %s", path)
+	}
+	source, _ = os.ReadFile(path)
+	if strings.Count(string(source), `schema.Alter("users", func(bp *blackhole.Blueprint) {`) != 2 {
+		t.Errorf("Expected the migration to alter users, got: %s", source)
+	}
+
+	if _, err := MakeMigration(dir, "Create Users", now); err == nil {
+		t.Errorf("Expected an error for an invalid migration name")
+	}
+}
+
+func TestRun_UnknownCommand(t *testing.T) {
+	var stdout bytes.Buffer
+	if err := Run(context.Background(), []string{"migrate:sideways"}, &stdout); err == nil {
+		t.Errorf("Expected an error for an unknown command")
+	}
+	if err := Run(context.Background(), nil, &stdout); err != nil || !strings.HasPrefix(stdout.String(), "Usage:") {
+		t.Errorf("Expected the usage, got: %s %v", stdout.String(), err)
+	}
+}
+
+func TestRun_Status(t *testing.T) {
+	ctx := context.Background()
+	_, db := openFake(t)
+	grammars["fake"] = blackhole.MySQL
+	t.Cleanup(func() { delete(grammars, "fake") })
+	if _, err := NewMigrator(db, blackhole.MySQL, testMigrations()[:1]).Migrate(ctx); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+
+	var stdout bytes.Buffer
+	if err := Run(ctx, []string{"migrate:status", "-driver", "fake", "-dsn", t.Name(), "-format", "json"}, &stdout); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if !strings.Contains(stdout.String(), `"state": "missing-from-code"`) {
+		t.Errorf("Expected the migration that is not registered to be missing from the code, got: %s", stdout.String())
+	}
+}
+
+func TestModels(t *testing.T) {
+	t.Setenv("GOPACKAGE", "entities")
+	options, err := ParseArgs([]string{"make:models", "-null", "sql"})
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if options.Path != "models_gen.go" || options.Package != "entities" || options.Null != "sql" {
+		t.Errorf("Unexpected options: %+v", options)
+	}
+
+	source, err := Models(blackhole.MySQL, testMigrations(), gen.Options{Package: options.Package})
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	users, posts := strings.Index(string(source), "type User struct"), strings.Index(string(source), "type Post struct")
+	if !strings.Contains(string(source), "package entities\n") || users < 0 || posts < users {
+		t.Errorf("Expected the models of users and posts in migration order, got: %s", source)
+	}
+}
@@ -0,0 +1,97 @@
+package migrate
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/uutkukorkmaz/blackhole"
+)
+
+// Config holds the settings of the migration command line. Every setting is read from an environment variable,
+// which may be set in an env file.
+type Config struct {
+	// Driver is the database/sql driver name, from DB_DRIVER. It defaults to "mysql".
+	Driver string
+	// DSN is the data source name of the database, from DB_DSN.
+	DSN string
+	// Dir is the directory of the migrations package, from MIGRATIONS_DIR. It defaults to "migrations".
+	Dir string
+	// Table is the name of the migrations table, from MIGRATIONS_TABLE. It defaults to "migrations".
+	Table string
+}
+
+// grammars maps the database/sql driver names to the grammar of their database.
+var grammars = map[string]blackhole.Grammar{
+	"mysql": blackhole.MySQL,
+}
+
+// LoadConfig loads the configuration from the environment and the env file. Variables set in the environment take
+// precedence over the ones in the file. The file is optional when envFile is empty, in which case ".env" is read
+// if it exists.
+func LoadConfig(envFile string) (Config, error) {
+	env := map[string]string{}
+	path := envFile
+	if path == "" {
+		path = ".env"
+	}
+	if err := readEnvFile(path, env); err != nil && (envFile != "" || !errors.Is(err, fs.ErrNotExist)) {
+		return Config{}, fmt.Errorf("blackhole: migrate: reading env file: %w", err)
+	}
+
+	lookup := func(key, fallback string) string {
+		if value, ok := os.LookupEnv(key); ok {
+			return value
+		}
+		if value, ok := env[key]; ok {
+			return value
+		}
+		return fallback
+	}
+	return Config{
+		Driver: lookup("DB_DRIVER", "mysql"),
+		DSN:    lookup("DB_DSN", ""),
+		Dir:    lookup("MIGRATIONS_DIR", "migrations"),
+		Table:  lookup("MIGRATIONS_TABLE", "migrations"),
+	}, nil
+}
+
+// Grammar returns the grammar of the configured driver.
+func (c Config) Grammar() (blackhole.Grammar, error) {
+	grammar, ok := grammars[c.Driver]
+	if !ok {
+		return nil, fmt.Errorf("blackhole: migrate: no grammar for the %q driver", c.Driver)
+	}
+	return grammar, nil
+}
+
+// readEnvFile reads the KEY=VALUE lines of an env file into env. Blank lines and lines starting with # are skipped,
+// an "export " prefix is allowed and values may be wrapped in single or double quotes.
+func readEnvFile(path string, env map[string]string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(text, "export "), "=")
+		if !ok {
+			return fmt.Errorf("%s:%d: expected KEY=VALUE", path, line)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		env[strings.TrimSpace(key)] = value
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,138 @@
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"text/template"
+	"time"
+)
+
+var (
+	migrationNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	createTablePattern   = regexp.MustCompile(`^create_(\w+?)_table$`)
+	alterTablePattern    = regexp.MustCompile(`_(?:to|from|in)_(\w+?)_table$`)
+	migrationFilePattern = regexp.MustCompile(`^(\d{14})_\w+\.go$`)
+)
+
+// migrationTimeLayout is the layout of the time the names of migrations start with.
+const migrationTimeLayout = "20060102150405"
+
+var migrationTemplate = template.Must(template.New("migration").Parse(`package main
+
+import (
+	"github.com/uutkukorkmaz/blackhole"
+	"github.com/uutkukorkmaz/blackhole/migrate"
+)
+
+func init() {
+	migrate.Register({{ printf "%q" .Name }},
+		func(schema *blackhole.Schema) {
+			{{- if .Create }}
+			schema.Create({{ printf "%q" .Table }}, func(bp *blackhole.Blueprint) {
+				bp.Id()
+				bp.Timestamps()
+			})
+			{{- else if .Table }}
+			schema.Alter({{ printf "%q" .Table }}, func(bp *blackhole.Blueprint) {
+			})
+			{{- end }}
+		},
+		func(schema *blackhole.Schema) {
+			{{- if .Create }}
+			schema.DropIfExists({{ printf "%q" .Table }})
+			{{- else if .Table }}
+			schema.Alter({{ printf "%q" .Table }}, func(bp *blackhole.Blueprint) {
+			})
+			{{- end }}
+		},
+	)
+}
+`))
+
+// mainSource is the main.go of a migrations package, written by the first make:migration command.
+const mainSource = `// Command migrations runs the migrations of this package, see "go run . help".
+package main
+
+import (
+	"github.com/uutkukorkmaz/blackhole/migrate"
+	// Import the database/sql driver of the database, e.g.:
+	// _ "github.com/go-sql-driver/mysql"
+)
+
+func main() {
+	migrate.Main()
+}
+`
+
+// MakeMigration writes a new migration file named after the time and the snake cased name to the migrations
+// package in dir, and returns its path. Names such as "create_users_table" or "add_votes_to_users_table" scaffold
+// the creation or alteration of the table. The directory, and the main.go running the package, are created
+// if they do not exist yet. The time is moved past the latest migration of the directory, so that migrations made
+// within the same second still run in the order they were made.
+func MakeMigration(dir, name string, now time.Time) (string, error) {
+	if !migrationNamePattern.MatchString(name) {
+		return "", fmt.Errorf("blackhole: migrate: invalid migration name %q, expected a snake cased name such as create_users_table", name)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	mainPath := filepath.Join(dir, "main.go")
+	if _, err := os.Stat(mainPath); errors.Is(err, fs.ErrNotExist) {
+		if err := os.WriteFile(mainPath, []byte(mainSource), 0o644); err != nil {
+			return "", fmt.Errorf("blackhole: migrate: %w", err)
+		}
+	}
+
+	data := struct {
+		Name   string
+		Table  string
+		Create bool
+	}{Name: migrationTime(dir, now).Format(migrationTimeLayout) + "_" + name}
+	if match := createTablePattern.FindStringSubmatch(name); match != nil {
+		data.Table, data.Create = match[1], true
+	} else if match := alterTablePattern.FindStringSubmatch(name); match != nil {
+		data.Table = match[1]
+	}
+
+	var source bytes.Buffer
+	if err := migrationTemplate.Execute(&source, data); err != nil {
+		return "", fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("blackhole: migrate: formatting migration: %w", err)
+	}
+
+	path := filepath.Join(dir, data.Name+".go")
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("blackhole: migrate: migration %s already exists", path)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		return "", fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	return path, nil
+}
+
+// migrationTime returns the time, truncated to seconds, or the second after the latest migration of the directory
+// if that is later.
+func migrationTime(dir string, now time.Time) time.Time {
+	t := now.UTC().Truncate(time.Second)
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		if latest, err := time.Parse(migrationTimeLayout, match[1]); err == nil && !latest.Before(t) {
+			t = latest.Add(time.Second)
+		}
+	}
+	return t
+}
@@ -0,0 +1,54 @@
+// Package migrate runs schema migrations written with the blackhole schema builder against a database/sql database,
+// keeping track of the migrations that ran in a migrations table.
+//
+// Migrations are registered from the init functions of the files scaffolded by the make:migration command, and run
+// by the command line of Main. The database/sql driver of the database has to be imported by the program.
+package migrate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/uutkukorkmaz/blackhole"
+)
+
+// Migration is a named, reversible change of the database schema. Migrations run in the order of their names,
+// which start with the time they were created at.
+type Migration struct {
+	Name string
+	Up   func(schema *blackhole.Schema)
+	Down func(schema *blackhole.Schema)
+}
+
+var (
+	registryMu sync.Mutex
+	registry   []Migration
+)
+
+// Register registers a migration to be run by Main. It panics if a migration with the same name is already registered.
+func Register(name string, up, down func(schema *blackhole.Schema)) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if slices.ContainsFunc(registry, func(m Migration) bool { return m.Name == name }) {
+		panic(fmt.Sprintf("blackhole: migrate: Register called twice for migration %q", name))
+	}
+	registry = append(registry, Migration{Name: name, Up: up, Down: down})
+}
+
+// Registered returns the registered migrations, sorted by name.
+func Registered() []Migration {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	return sortedMigrations(registry)
+}
+
+// sortedMigrations returns a copy of the migrations sorted by name.
+func sortedMigrations(migrations []Migration) []Migration {
+	sorted := slices.Clone(migrations)
+	slices.SortFunc(sorted, func(a, b Migration) int { return strings.Compare(a.Name, b.Name) })
+	return sorted
+}
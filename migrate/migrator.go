@@ -0,0 +1,364 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/uutkukorkmaz/blackhole"
+)
+
+// Record is a row of the migrations table: a migration that ran, and the batch it ran in.
+type Record struct {
+	Name       string
+	Batch      int
+	MigratedAt time.Time
+}
+
+// querier is implemented by both *sql.DB and *sql.Conn.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Migrator runs migrations against a database, recording them in the migrations table.
+type Migrator struct {
+	db         *sql.DB
+	grammar    blackhole.Grammar
+	table      string
+	migrations []Migration
+}
+
+// NewMigrator creates a new Migrator running the migrations on the database, in the order of their names,
+// with the statements compiled by the grammar.
+func NewMigrator(db *sql.DB, grammar blackhole.Grammar, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		grammar:    grammar,
+		table:      "migrations",
+		migrations: sortedMigrations(migrations),
+	}
+}
+
+// Table sets the name of the table the migrations that ran are recorded in. It defaults to "migrations".
+func (m *Migrator) Table(name string) *Migrator {
+	m.table = name
+	return m
+}
+
+// Migrate runs the migrations that have not run yet, as a new batch, and returns their names.
+// It stops at the first migration that fails; the migrations before it stay recorded.
+func (m *Migrator) Migrate(ctx context.Context) ([]string, error) {
+	records, err := m.Ran(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := 1
+	ran := map[string]bool{}
+	for _, r := range records {
+		ran[r.Name] = true
+		batch = max(batch, r.Batch+1)
+	}
+
+	table, err := m.grammar.WrapTable(m.table)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrated []string
+	for _, migration := range m.migrations {
+		if ran[migration.Name] {
+			continue
+		}
+		if migration.Up == nil {
+			return migrated, fmt.Errorf("blackhole: migrate: migration %s has no Up", migration.Name)
+		}
+		if err := m.run(ctx, m.db, migration.Up); err != nil {
+			return migrated, fmt.Errorf("blackhole: migrate: %s: %w", migration.Name, err)
+		}
+		insert := fmt.Sprintf("insert into %s (migration, batch) values (?, ?)", table)
+		if _, err := m.db.ExecContext(ctx, insert, migration.Name, batch); err != nil {
+			return migrated, fmt.Errorf("blackhole: migrate: recording %s: %w", migration.Name, err)
+		}
+		migrated = append(migrated, migration.Name)
+	}
+	return migrated, nil
+}
+
+// Rollback reverts the migrations of the last steps batches, latest first, and returns their names.
+// A steps value below one rolls back the last batch.
+func (m *Migrator) Rollback(ctx context.Context, steps int) ([]string, error) {
+	records, err := m.Ran(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var batches []int
+	for _, r := range records {
+		if !slices.Contains(batches, r.Batch) {
+			batches = append(batches, r.Batch)
+		}
+	}
+	slices.Sort(batches)
+	batches = batches[len(batches)-min(max(steps, 1), len(batches)):]
+
+	table, err := m.grammar.WrapTable(m.table)
+	if err != nil {
+		return nil, err
+	}
+
+	var rolledBack []string
+	for i := len(records) - 1; i >= 0; i-- {
+		record := records[i]
+		if !slices.Contains(batches, record.Batch) {
+			continue
+		}
+		j := slices.IndexFunc(m.migrations, func(migration Migration) bool { return migration.Name == record.Name })
+		if j < 0 {
+			return rolledBack, fmt.Errorf("blackhole: migrate: cannot roll back %s: the migration is missing from the code", record.Name)
+		}
+		if m.migrations[j].Down == nil {
+			return rolledBack, fmt.Errorf("blackhole: migrate: migration %s has no Down", record.Name)
+		}
+		if err := m.run(ctx, m.db, m.migrations[j].Down); err != nil {
+			return rolledBack, fmt.Errorf("blackhole: migrate: rolling back %s: %w", record.Name, err)
+		}
+		remove := fmt.Sprintf("delete from %s where migration = ?", table)
+		if _, err := m.db.ExecContext(ctx, remove, record.Name); err != nil {
+			return rolledBack, fmt.Errorf("blackhole: migrate: unrecording %s: %w", record.Name, err)
+		}
+		rolledBack = append(rolledBack, record.Name)
+	}
+	return rolledBack, nil
+}
+
+// Fresh drops every view and table of the database, the migrations table included, and runs all migrations again.
+func (m *Migrator) Fresh(ctx context.Context) ([]string, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	defer conn.Close()
+
+	views, err := m.list(ctx, conn, m.grammar.CompileViewListing, "views")
+	if err != nil {
+		return nil, err
+	}
+	tables, err := m.list(ctx, conn, m.grammar.CompileTableListing, "tables")
+	if err != nil {
+		return nil, err
+	}
+
+	// Foreign key checks are disabled on a single connection, as they are a setting of the session.
+	disable, err := m.grammar.CompileDisableForeignKeyConstraints()
+	if err != nil {
+		return nil, err
+	}
+	enable, err := m.grammar.CompileEnableForeignKeyConstraints()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.ExecContext(ctx, disable); err != nil {
+		return nil, fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	err = m.run(ctx, conn, func(schema *blackhole.Schema) {
+		for _, view := range views {
+			schema.DropView(view)
+		}
+		for _, table := range tables {
+			schema.DropIfExists(table)
+		}
+	})
+	if _, enableErr := conn.ExecContext(ctx, enable); err == nil && enableErr != nil {
+		err = fmt.Errorf("blackhole: migrate: %w", enableErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("blackhole: migrate: dropping views and tables: %w", err)
+	}
+
+	return m.Migrate(ctx)
+}
+
+// Ran returns the migrations recorded in the migrations table in the order they ran, creating the table
+// if it does not exist yet.
+func (m *Migrator) Ran(ctx context.Context) ([]Record, error) {
+	err := m.run(ctx, m.db, func(schema *blackhole.Schema) {
+		schema.CreateIfNotExists(m.table, func(bp *blackhole.Blueprint) {
+			bp.Id()
+			bp.String("migration", 255).NotNull()
+			bp.Int("batch").NotNull()
+			bp.Timestamp("migrated_at").Default("CURRENT_TIMESTAMP")
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("blackhole: migrate: creating the migrations table: %w", err)
+	}
+
+	table, err := m.grammar.WrapTable(m.table)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.db.QueryContext(ctx, fmt.Sprintf("select migration, batch, migrated_at from %s order by id", table))
+	if err != nil {
+		return nil, fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var record Record
+		var migratedAt sql.NullString
+		if err := rows.Scan(&record.Name, &record.Batch, &migratedAt); err != nil {
+			return nil, fmt.Errorf("blackhole: migrate: %w", err)
+		}
+		record.MigratedAt = parseTime(migratedAt.String)
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	return records, nil
+}
+
+// Dump writes the create statements of the tables of the database, followed by those of its views and the rows of
+// the migrations table, so that loading the dump leaves a database that only needs the migrations created after it.
+// Foreign key checks are disabled while the dump loads, as tables are written in alphabetical order.
+func (m *Migrator) Dump(ctx context.Context, w io.Writer) error {
+	records, err := m.Ran(ctx)
+	if err != nil {
+		return err
+	}
+	tables, err := m.list(ctx, m.db, m.grammar.CompileTableListing, "tables")
+	if err != nil {
+		return err
+	}
+	views, err := m.list(ctx, m.db, m.grammar.CompileViewListing, "views")
+	if err != nil {
+		return err
+	}
+	migrationsTable, err := m.grammar.WrapTable(m.table)
+	if err != nil {
+		return err
+	}
+	disable, err := m.grammar.CompileDisableForeignKeyConstraints()
+	if err != nil {
+		return err
+	}
+	enable, err := m.grammar.CompileEnableForeignKeyConstraints()
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\n\n", disable); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := m.dumpDefinition(ctx, w, table, m.grammar.CompileTableDefinition); err != nil {
+			return err
+		}
+	}
+	for _, view := range views {
+		if err := m.dumpDefinition(ctx, w, view, m.grammar.CompileViewDefinition); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		name := strings.ReplaceAll(record.Name, "'", "''")
+		if _, err := fmt.Fprintf(w, "insert into %s (migration, batch) values ('%s', %d);\n", migrationsTable, name, record.Batch); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "\n%s\n", enable)
+	return err
+}
+
+// dumpDefinition writes the create statement of the table or view, queried with the statement compiled by the
+// grammar. The statement is read from the second column of the result, after the name.
+func (m *Migrator) dumpDefinition(ctx context.Context, w io.Writer, name string, compile func(string) (string, error)) error {
+	query, err := compile(name)
+	if err != nil {
+		return err
+	}
+	rows, err := m.db.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("blackhole: migrate: dumping %s: %w", name, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil || len(columns) < 2 {
+		return fmt.Errorf("blackhole: migrate: dumping %s: expected the name and the create statement, got %v %v", name, columns, err)
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("blackhole: migrate: dumping %s: %w", name, err)
+		}
+		return fmt.Errorf("blackhole: migrate: dumping %s: %w", name, sql.ErrNoRows)
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return fmt.Errorf("blackhole: migrate: dumping %s: %w", name, err)
+	}
+	_, err = fmt.Fprintf(w, "%s;\n\n", values[1])
+	return err
+}
+
+// list returns the names the listing query compiled by the grammar returns, such as those of the tables
+// or the views of the database.
+func (m *Migrator) list(ctx context.Context, q querier, compile func() (string, error), what string) ([]string, error) {
+	query, err := compile()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("blackhole: migrate: listing %s: %w", what, err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("blackhole: migrate: listing %s: %w", what, err)
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// run builds the schema changes of the callback and executes their statements one by one.
+func (m *Migrator) run(ctx context.Context, q querier, callback func(schema *blackhole.Schema)) error {
+	schema := blackhole.NewSchema(m.grammar)
+	callback(schema)
+	statements, err := schema.Statements()
+	if err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		if _, err := q.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseTime parses a timestamp as returned by the driver: in the database format, or as the RFC 3339 format
+// database/sql converts time.Time values to when scanning them into strings.
+func parseTime(value string) time.Time {
+	for _, layout := range []string{time.DateTime, time.RFC3339Nano} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
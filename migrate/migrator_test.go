@@ -0,0 +1,390 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/uutkukorkmaz/blackhole"
+)
+
+// fakeDatabase is an in-memory database recording the statements executed on it, and keeping the rows of the
+// migrations table and the names of the tables and views created by the migrations.
+type fakeDatabase struct {
+	mu       sync.Mutex
+	executed []string
+	records  []Record
+	tables   []string
+	views    []string
+	failOn   string
+}
+
+var (
+	fakeDatabasesMu sync.Mutex
+	fakeDatabases   = map[string]*fakeDatabase{}
+)
+
+func init() {
+	sql.Register("fake", fakeDriver{})
+}
+
+// openFake opens a new fake database, returning it along with a database/sql handle on it.
+func openFake(t *testing.T) (*fakeDatabase, *sql.DB) {
+	fakeDatabasesMu.Lock()
+	fake := &fakeDatabase{}
+	fakeDatabases[t.Name()] = fake
+	fakeDatabasesMu.Unlock()
+
+	db, err := sql.Open("fake", t.Name())
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return fake, db
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeDatabasesMu.Lock()
+	defer fakeDatabasesMu.Unlock()
+	return &fakeConn{db: fakeDatabases[name]}, nil
+}
+
+type fakeConn struct{ db *fakeDatabase }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("fake: transactions are not supported")
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	db := c.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.failOn != "" && strings.Contains(query, db.failOn) {
+		return nil, fmt.Errorf("fake: failing on %s", db.failOn)
+	}
+	db.executed = append(db.executed, query)
+
+	switch {
+	case strings.HasPrefix(query, "insert into `migrations`"):
+		db.records = append(db.records, Record{Name: args[0].Value.(string), Batch: int(args[1].Value.(int64)), MigratedAt: time.Now()})
+	case strings.HasPrefix(query, "delete from `migrations`"):
+		db.records = slices.DeleteFunc(db.records, func(r Record) bool { return r.Name == args[0].Value.(string) })
+	case strings.HasPrefix(query, "create table"):
+		table := quoted(query)
+		if !slices.Contains(db.tables, table) {
+			db.tables = append(db.tables, table)
+		}
+	case strings.HasPrefix(query, "create view"):
+		db.views = append(db.views, quoted(query))
+	case strings.HasPrefix(query, "drop view"):
+		view := quoted(query)
+		db.views = slices.DeleteFunc(db.views, func(name string) bool { return name == view })
+	case strings.HasPrefix(query, "drop table"):
+		table := quoted(query)
+		db.tables = slices.DeleteFunc(db.tables, func(name string) bool { return name == table })
+		if table == "migrations" {
+			db.records = nil
+		}
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	db := c.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	rows := &fakeRows{}
+	switch {
+	case strings.HasPrefix(query, "select migration, batch, migrated_at from `migrations`"):
+		rows.columns = []string{"migration", "batch", "migrated_at"}
+		for _, r := range db.records {
+			rows.values = append(rows.values, []driver.Value{r.Name, int64(r.Batch), r.MigratedAt})
+		}
+	case strings.HasPrefix(query, "select table_name from information_schema.views"):
+		rows.columns = []string{"table_name"}
+		for _, view := range db.views {
+			rows.values = append(rows.values, []driver.Value{view})
+		}
+	case strings.HasPrefix(query, "select table_name"):
+		rows.columns = []string{"table_name"}
+		for _, table := range db.tables {
+			rows.values = append(rows.values, []driver.Value{table})
+		}
+	case strings.HasPrefix(query, "show create table"):
+		table := quoted(query)
+		rows.columns = []string{"Table", "Create Table"}
+		rows.values = [][]driver.Value{{table, "create table `" + table + "` (...)"}}
+	case strings.HasPrefix(query, "show create view"):
+		view := quoted(query)
+		rows.columns = []string{"View", "Create View", "character_set_client", "collation_connection"}
+		rows.values = [][]driver.Value{{view, "create view `" + view + "` as select ...", "utf8mb4", "utf8mb4_0900_ai_ci"}}
+	default:
+		return nil, fmt.Errorf("fake: unexpected query %s", query)
+	}
+	return rows, nil
+}
+
+// quoted returns the first quoted identifier of the query.
+func quoted(query string) string {
+	return strings.Split(query, "`")[1]
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{
+			Name: "20260102000000_create_posts_table",
+			Up: func(schema *blackhole.Schema) {
+				schema.Create("posts", func(bp *blackhole.Blueprint) {
+					bp.Id()
+				})
+			},
+			Down: func(schema *blackhole.Schema) {
+				schema.DropIfExists("posts")
+			},
+		},
+		{
+			Name: "20260101000000_create_users_table",
+			Up: func(schema *blackhole.Schema) {
+				schema.Create("users", func(bp *blackhole.Blueprint) {
+					bp.Id()
+				})
+			},
+			Down: func(schema *blackhole.Schema) {
+				schema.DropIfExists("users")
+			},
+		},
+	}
+}
+
+func names(records []Record) []string {
+	var names []string
+	for _, r := range records {
+		names = append(names, fmt.Sprintf("%s@%d", r.Name, r.Batch))
+	}
+	return names
+}
+
+func TestMigrator_MigrateAndRollback(t *testing.T) {
+	ctx := context.Background()
+	fake, db := openFake(t)
+	migrations := testMigrations()
+	migrator := NewMigrator(db, blackhole.MySQL, migrations[1:])
+
+	migrated, err := migrator.Migrate(ctx)
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if !slices.Equal(migrated, []string{"20260101000000_create_users_table"}) {
+		t.Errorf("Unexpected migrations: %v", migrated)
+	}
+
+	migrator = NewMigrator(db, blackhole.MySQL, migrations)
+	if migrated, err = migrator.Migrate(ctx); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if !slices.Equal(migrated, []string{"20260102000000_create_posts_table"}) {
+		t.Errorf("Unexpected migrations: %v", migrated)
+	}
+	if migrated, _ = migrator.Migrate(ctx); len(migrated) != 0 {
+		t.Errorf("Expected nothing to migrate, got %v", migrated)
+	}
+
+	records, err := migrator.Ran(ctx)
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	expected := []string{"20260101000000_create_users_table@1", "20260102000000_create_posts_table@2"}
+	if !slices.Equal(names(records), expected) {
+		t.Errorf("Expected: %v, got: %v", expected, names(records))
+	}
+	if !slices.Equal(fake.tables, []string{"migrations", "users", "posts"}) {
+		t.Errorf("Unexpected tables: %v", fake.tables)
+	}
+
+	rolledBack, err := migrator.Rollback(ctx, 1)
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if !slices.Equal(rolledBack, []string{"20260102000000_create_posts_table"}) {
+		t.Errorf("Unexpected rollback: %v", rolledBack)
+	}
+	if !slices.Equal(fake.tables, []string{"migrations", "users"}) {
+		t.Errorf("Unexpected tables: %v", fake.tables)
+	}
+}
+
+func TestMigrator_RollbackMissingMigration(t *testing.T) {
+	ctx := context.Background()
+	_, db := openFake(t)
+	migrations := testMigrations()
+
+	if _, err := NewMigrator(db, blackhole.MySQL, migrations).Migrate(ctx); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	_, err := NewMigrator(db, blackhole.MySQL, migrations[1:]).Rollback(ctx, 1)
+	if err == nil || !strings.Contains(err.Error(), "missing from the code") {
+		t.Errorf("Expected a missing migration error, got: %v", err)
+	}
+}
+
+func TestMigrator_RollbackWithoutDown(t *testing.T) {
+	ctx := context.Background()
+	fake, db := openFake(t)
+	migrations := testMigrations()
+	migrations[0].Down = nil
+
+	if _, err := NewMigrator(db, blackhole.MySQL, migrations).Migrate(ctx); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	_, err := NewMigrator(db, blackhole.MySQL, migrations).Rollback(ctx, 1)
+	if err == nil || !strings.Contains(err.Error(), "migration 20260102000000_create_posts_table has no Down") {
+		t.Errorf("Expected a missing Down error, got: %v", err)
+	}
+	if len(fake.records) != 2 {
+		t.Errorf("Expected the migration to stay recorded, got: %v", names(fake.records))
+	}
+}
+
+func TestMigrator_MigrateStopsAtFailure(t *testing.T) {
+	ctx := context.Background()
+	fake, db := openFake(t)
+	fake.failOn = "`posts`"
+
+	migrated, err := NewMigrator(db, blackhole.MySQL, testMigrations()).Migrate(ctx)
+	if err == nil || !strings.Contains(err.Error(), "20260102000000_create_posts_table") {
+		t.Errorf("Expected the posts migration to fail, got: %v", err)
+	}
+	if !slices.Equal(migrated, []string{"20260101000000_create_users_table"}) || len(fake.records) != 1 {
+		t.Errorf("Expected only the users migration to be recorded, got: %v", migrated)
+	}
+}
+
+func TestMigrator_FreshAndDump(t *testing.T) {
+	ctx := context.Background()
+	fake, db := openFake(t)
+	migrator := NewMigrator(db, blackhole.MySQL, testMigrations())
+
+	if _, err := migrator.Migrate(ctx); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	fake.views = []string{"active_users"}
+	fake.executed = nil
+
+	migrated, err := migrator.Fresh(ctx)
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if len(migrated) != 2 {
+		t.Errorf("Expected every migration to run again, got: %v", migrated)
+	}
+	expected := []string{
+		"set foreign_key_checks = 0;",
+		"drop view `active_users`;",
+		"drop table if exists `migrations`;",
+		"drop table if exists `users`;",
+		"drop table if exists `posts`;",
+		"set foreign_key_checks = 1;",
+	}
+	if !slices.Equal(fake.executed[:len(expected)], expected) {
+		t.Errorf("Expected: %v, got: %v", expected, fake.executed[:len(expected)])
+	}
+
+	fake.views = []string{"active_users"}
+	var dump strings.Builder
+	if err := migrator.Dump(ctx, &dump); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	expectedDump := "set foreign_key_checks = 0;\n\n" +
+		"create table `migrations` (...);\n\n" +
+		"create table `users` (...);\n\n" +
+		"create table `posts` (...);\n\n" +
+		"create view `active_users` as select ...;\n\n" +
+		"insert into `migrations` (migration, batch) values ('20260101000000_create_users_table', 1);\n" +
+		"insert into `migrations` (migration, batch) values ('20260102000000_create_posts_table', 1);\n" +
+		"\nset foreign_key_checks = 1;\n"
+	if dump.String() != expectedDump {
+		t.Errorf("Expected: %s", expectedDump)
+		t.Errorf("Got: %s", dump.String())
+	}
+}
+
+func TestMigrator_Status(t *testing.T) {
+	ctx := context.Background()
+	fake, db := openFake(t)
+	migrations := testMigrations()
+
+	if _, err := NewMigrator(db, blackhole.MySQL, migrations).Migrate(ctx); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	appliedAt := time.Date(2026, 10, 18, 9, 15, 0, 0, time.UTC)
+	for i := range fake.records {
+		fake.records[i].MigratedAt = appliedAt
+	}
+
+	pending := Migration{Name: "20260103000000_create_comments_table"}
+	statuses, err := NewMigrator(db, blackhole.MySQL, []Migration{migrations[1], pending}).Status(ctx)
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+
+	var table strings.Builder
+	if err := WriteStatusTable(&table, statuses); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	expectedTable := "Migration                             Batch  Applied at           State\n" +
+		"20260101000000_create_users_table     1      2026-10-18 09:15:00  applied\n" +
+		"20260102000000_create_posts_table     1      2026-10-18 09:15:00  missing-from-code\n" +
+		"20260103000000_create_comments_table                              pending\n"
+	if table.String() != expectedTable {
+		t.Errorf("Expected: %s", expectedTable)
+		t.Errorf("Got: %s", table.String())
+	}
+
+	var output strings.Builder
+	if err := WriteStatusJSON(&output, statuses[2:]); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	expectedJSON := "[\n  {\n    \"name\": \"20260103000000_create_comments_table\",\n    \"state\": \"pending\"\n  }\n]\n"
+	if output.String() != expectedJSON {
+		t.Errorf("Expected: %s", expectedJSON)
+		t.Errorf("Got: %s", output.String())
+	}
+
+	output.Reset()
+	if err := WriteStatusJSON(&output, statuses[:1]); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if !strings.Contains(output.String(), `"batch": 1`) || !strings.Contains(output.String(), `"applied_at": "2026-10-18T09:15:00Z"`) {
+		t.Errorf("Unexpected JSON: %s", output.String())
+	}
+}
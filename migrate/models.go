@@ -0,0 +1,48 @@
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/uutkukorkmaz/blackhole"
+	"github.com/uutkukorkmaz/blackhole/gen"
+)
+
+// Models returns the Go source of the models of the tables the migrations create, replaying them in order on
+// a schema of the grammar. See gen.Generate for how tables are mapped to models.
+func Models(grammar blackhole.Grammar, migrations []Migration, options gen.Options) ([]byte, error) {
+	schema := blackhole.NewSchema(grammar)
+	for _, migration := range sortedMigrations(migrations) {
+		if migration.Up != nil {
+			migration.Up(schema)
+		}
+	}
+	return gen.Generate(schema, options)
+}
+
+// makeModels writes the models of the registered migrations to the path of the options, or to stdout for "-".
+func makeModels(options Options, stdout io.Writer) error {
+	grammar, err := options.Config.Grammar()
+	if err != nil {
+		return err
+	}
+	null, err := gen.ParseNullStyle(options.Null)
+	if err != nil {
+		return err
+	}
+
+	source, err := Models(grammar, Registered(), gen.Options{Package: options.Package, NullStyle: null})
+	if err != nil {
+		return err
+	}
+	if options.Path == "-" {
+		_, err = stdout.Write(source)
+		return err
+	}
+	if err := os.WriteFile(options.Path, source, 0o644); err != nil {
+		return fmt.Errorf("blackhole: migrate: %w", err)
+	}
+	fmt.Fprintf(stdout, "Generated the models to %s\n", options.Path)
+	return nil
+}
@@ -0,0 +1,89 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// State is whether a migration has run.
+type State string
+
+const (
+	// StateApplied is the state of a migration that has run.
+	StateApplied State = "applied"
+	// StatePending is the state of a migration that has not run yet.
+	StatePending State = "pending"
+	// StateMissing is the state of a migration that has run, but is no longer part of the code.
+	StateMissing State = "missing-from-code"
+)
+
+// MigrationStatus is the status of a known migration. Batch and AppliedAt are only set for migrations that ran.
+type MigrationStatus struct {
+	Name      string     `json:"name"`
+	State     State      `json:"state"`
+	Batch     int        `json:"batch,omitempty"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+// Status returns the status of every migration of the code and of the migrations table, sorted by name.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	records, err := m.Ran(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MigrationStatus
+	for _, migration := range m.migrations {
+		statuses = append(statuses, MigrationStatus{Name: migration.Name, State: StatePending})
+	}
+	for _, record := range records {
+		i := slices.IndexFunc(statuses, func(s MigrationStatus) bool { return s.Name == record.Name })
+		if i < 0 {
+			statuses = append(statuses, MigrationStatus{Name: record.Name, State: StateMissing})
+			i = len(statuses) - 1
+		} else {
+			statuses[i].State = StateApplied
+		}
+		statuses[i].Batch = record.Batch
+		if !record.MigratedAt.IsZero() {
+			statuses[i].AppliedAt = &record.MigratedAt
+		}
+	}
+
+	slices.SortFunc(statuses, func(a, b MigrationStatus) int { return strings.Compare(a.Name, b.Name) })
+	return statuses, nil
+}
+
+// WriteStatusTable writes the statuses as an aligned text table.
+func WriteStatusTable(w io.Writer, statuses []MigrationStatus) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Migration\tBatch\tApplied at\tState")
+	for _, s := range statuses {
+		var batch, appliedAt string
+		if s.Batch > 0 {
+			batch = strconv.Itoa(s.Batch)
+		}
+		if s.AppliedAt != nil {
+			appliedAt = s.AppliedAt.Format(time.DateTime)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", s.Name, batch, appliedAt, s.State)
+	}
+	return tw.Flush()
+}
+
+// WriteStatusJSON writes the statuses as an indented JSON array.
+func WriteStatusJSON(w io.Writer, statuses []MigrationStatus) error {
+	if statuses == nil {
+		statuses = []MigrationStatus{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(statuses)
+}
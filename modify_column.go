@@ -0,0 +1,21 @@
+package blackhole
+
+// ModifyColumn represents changing the definition of an existing column.
+type ModifyColumn struct {
+	Definition
+	column *Column
+}
+
+func NewModifyColumn(column *Column) *ModifyColumn {
+	return &ModifyColumn{
+		column: column,
+	}
+}
+
+func (m *ModifyColumn) Column() *Column {
+	return m.column
+}
+
+func (m *ModifyColumn) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileModifyColumn(m)
+}
@@ -2,8 +2,8 @@ package blackhole
 
 import (
 	"fmt"
-
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -16,6 +16,11 @@ func NewMySqlGrammar() *MySqlGrammar {
 	return &MySqlGrammar{}
 }
 
+// Dialect returns the MySQL dialect.
+func (m *MySqlGrammar) Dialect() Dialect {
+	return DialectMySQL
+}
+
 // GetDefaultCollation provides a default collation for the grammar.
 func (m *MySqlGrammar) GetDefaultCollation() (string, error) {
 	return "utf8mb4_unicode_ci", nil
@@ -31,6 +36,18 @@ func (m *MySqlGrammar) CompileAutoIncrement(a *AutoIncrements) (string, error) {
 	return "auto_increment", nil
 }
 
+// CompileIdentity returns the identity SQL for MySQL. An identity generated by default is compiled as auto increment,
+// MySQL has neither identities generated always nor sequence options for them.
+func (m *MySqlGrammar) CompileIdentity(i *Identity) (string, error) {
+	if i.IsAlways() {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileIdentity: identities generated always: %w", ErrNotSupported)
+	}
+	if i.GetOptions() != (SequenceOptions{}) {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileIdentity: identity sequence options: %w", ErrNotSupported)
+	}
+	return "auto_increment", nil
+}
+
 // CompileNullable returns the nullable SQL for MySQL.
 func (m *MySqlGrammar) CompileNullable(n *Nullable) (string, error) {
 	if n.Is() {
@@ -39,6 +56,15 @@ func (m *MySqlGrammar) CompileNullable(n *Nullable) (string, error) {
 	return "not null", nil
 }
 
+// CompileGenerated returns the generated column SQL for MySQL.
+func (m *MySqlGrammar) CompileGenerated(g *Generated) (string, error) {
+	storage := "virtual"
+	if g.IsStored() {
+		storage = "stored"
+	}
+	return fmt.Sprintf("generated always as (%s) %s", g.Get(), storage), nil
+}
+
 // CompileDefaultValue returns the default value SQL for MySQL.
 func (m *MySqlGrammar) CompileDefaultValue(d *DefaultValue) (string, error) {
 	return d.Get(), nil
@@ -53,9 +79,12 @@ func (m *MySqlGrammar) CompileComment(c *Comment) (string, error) {
 // It compiles various attributes of the column, such as name, data type, length, nullability, etc.
 func (m *MySqlGrammar) CompileColumn(c *Column) (string, error) {
 	var result string
-	dataTypeString := string(c.GetDataType())
+	dataTypeString := m.compileType(c.GetDataType())
 
 	// Handle enum data type if applicable
+	if c.GetEnumType() != "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileColumn: named enum types: %w", ErrNotSupported)
+	}
 	if c.GetEnumValues() != nil {
 		dts, err := (*c.GetEnumValues()).Expression(m)
 		if err != nil {
@@ -72,11 +101,38 @@ func (m *MySqlGrammar) CompileColumn(c *Column) (string, error) {
 		result += "(" + strconv.Itoa(c.GetLength()) + ")"
 	}
 
+	// Add fractional seconds precision of time columns if specified
+	if c.GetDataType().HasFractionalSeconds() && c.GetPrecision() > 0 {
+		result += "(" + strconv.Itoa(c.GetPrecision()) + ")"
+	}
+
 	// Handle unsigned attribute
 	if c.IsUnsigned() {
 		result += " unsigned"
 	}
 
+	// Handle spatial reference system attribute
+	if c.GetSRID() > 0 {
+		result += " srid " + strconv.Itoa(c.GetSRID())
+	}
+
+	// Handle character set and collation attributes
+	if c.GetCharSet() != "" {
+		result += " character set " + c.GetCharSet()
+	}
+	if c.GetCollation() != "" {
+		result += fmt.Sprintf(" collate '%s'", c.GetCollation())
+	}
+
+	// Handle generated column expression
+	if c.GetGenerated() != nil {
+		generated, err := c.GetGenerated().Expression(m)
+		if err != nil {
+			return "", err
+		}
+		result += " " + generated
+	}
+
 	// Handle nullable attribute
 	if c.GetNullable() != nil {
 		nullable, err := c.GetNullable().Expression(m)
@@ -95,6 +151,15 @@ func (m *MySqlGrammar) CompileColumn(c *Column) (string, error) {
 		result += " " + ai
 	}
 
+	// Handle identity attribute
+	if c.GetIdentity() != nil {
+		identity, err := c.GetIdentity().Expression(m)
+		if err != nil {
+			return "", err
+		}
+		result += " " + identity
+	}
+
 	// Handle primary key attribute
 	if c.IsPrimary() {
 		result += " primary key"
@@ -112,6 +177,11 @@ func (m *MySqlGrammar) CompileColumn(c *Column) (string, error) {
 		result += " default " + defaultValue
 	}
 
+	// Handle check constraint attribute
+	if c.GetCheck() != "" {
+		result += " check (" + c.GetCheck() + ")"
+	}
+
 	// Handle comment attribute
 	if c.GetComment() != nil {
 		comment, err := c.GetComment().Expression(m)
@@ -124,12 +194,24 @@ func (m *MySqlGrammar) CompileColumn(c *Column) (string, error) {
 	return result, nil
 }
 
+// compileType returns the MySQL type of the column type.
+// MySQL has no timezone aware types: timestamp values are stored in UTC, while datetime values are stored as given.
+func (m *MySqlGrammar) compileType(t ColumnType) string {
+	switch t {
+	case ColumnTypeTimestampTz:
+		return string(ColumnTypeTimestamp)
+	case ColumnTypeDateTimeTz:
+		return string(ColumnTypeDateTime)
+	}
+	return string(t)
+}
+
 // Build returns the final runnable SQL for MySQL.
-// It constructs the SQL statement based on the blueprint mode (create, drop, alter).
+// It constructs the SQL statement based on the blueprint mode (create, drop, alter, rename, truncate).
 func (m *MySqlGrammar) Build(b *Blueprint) (string, error) {
 	var sql string
 	modeDirective := m.getDirective(b.Mode())
-	sql = fmt.Sprintf("%s `%s`", modeDirective, b.GetTable())
+	sql = fmt.Sprintf("%s %s", modeDirective, m.wrapTable(b.GetTable()))
 	blueprintCompile, err := m.callCompileFunctionsByMode(b)
 	if err != nil {
 		return "", err
@@ -142,11 +224,19 @@ func (m *MySqlGrammar) Build(b *Blueprint) (string, error) {
 func (m *MySqlGrammar) getDirective(mode string) string {
 	switch mode {
 	case "create":
+		return "create table"
+	case "createIfNotExists":
 		return "create table if not exists"
 	case "drop":
+		return "drop table"
+	case "dropIfExists":
 		return "drop table if exists"
 	case "alter":
 		return "alter table"
+	case "rename":
+		return "rename table"
+	case "truncate":
+		return "truncate table"
 	}
 	panic("blackhole: MySQL build: invalid blueprint mode given : " + mode)
 }
@@ -155,18 +245,30 @@ func (m *MySqlGrammar) getDirective(mode string) string {
 func (m *MySqlGrammar) callCompileFunctionsByMode(b *Blueprint) (string, error) {
 	var sql string
 	switch b.Mode() {
-	case "create":
+	case "create", "createIfNotExists":
 		create, err := m.CompileCreateTable(*b)
 		if err != nil {
 			return "", err
 		}
 		sql += create
-	case "drop":
+	case "drop", "dropIfExists":
 		drop, err := m.CompileDropTable(*b)
 		if err != nil {
 			return "", err
 		}
 		sql += drop
+	case "rename":
+		rename, err := m.CompileRenameTable(*b)
+		if err != nil {
+			return "", err
+		}
+		sql += rename
+	case "truncate":
+		truncate, err := m.CompileTruncateTable(*b)
+		if err != nil {
+			return "", err
+		}
+		sql += truncate
 	case "alter":
 		alter, err := m.CompileAlterTable(*b)
 		if err != nil {
@@ -177,6 +279,68 @@ func (m *MySqlGrammar) callCompileFunctionsByMode(b *Blueprint) (string, error)
 	return sql, nil
 }
 
+// CompileCreateNamespace returns the SQL for creating a namespace in MySQL, where it is a schema (database).
+func (m *MySqlGrammar) CompileCreateNamespace(namespace *Namespace) (string, error) {
+	directive := "create schema"
+	if namespace.Mode() == "createIfNotExists" {
+		directive += " if not exists"
+	}
+	return fmt.Sprintf("%s `%s`;", directive, namespace.GetName()), nil
+}
+
+// CompileDropNamespace returns the SQL for dropping a namespace in MySQL.
+func (m *MySqlGrammar) CompileDropNamespace(namespace *Namespace) (string, error) {
+	directive := "drop schema"
+	if namespace.Mode() == "dropIfExists" {
+		directive += " if exists"
+	}
+	return fmt.Sprintf("%s `%s`;", directive, namespace.GetName()), nil
+}
+
+// CompileCreateEnumType returns an error as MySQL has no named enum types, only inline enum columns.
+func (m *MySqlGrammar) CompileCreateEnumType(_ *EnumType) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateEnumType: %w", ErrNotSupported)
+}
+
+// CompileAddEnumTypeValue returns an error as MySQL has no named enum types, only inline enum columns.
+func (m *MySqlGrammar) CompileAddEnumTypeValue(_ *EnumType, _ string) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileAddEnumTypeValue: %w", ErrNotSupported)
+}
+
+// CompileDropEnumType returns an error as MySQL has no named enum types, only inline enum columns.
+func (m *MySqlGrammar) CompileDropEnumType(_ *EnumType) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileDropEnumType: %w", ErrNotSupported)
+}
+
+// CompileCreateSequence returns an error as MySQL has no standalone sequences.
+func (m *MySqlGrammar) CompileCreateSequence(_ *Sequence) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateSequence: %w", ErrNotSupported)
+}
+
+// CompileAlterSequence returns an error as MySQL has no standalone sequences.
+func (m *MySqlGrammar) CompileAlterSequence(_ *Sequence) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileAlterSequence: %w", ErrNotSupported)
+}
+
+// CompileDropSequence returns an error as MySQL has no standalone sequences.
+func (m *MySqlGrammar) CompileDropSequence(_ *Sequence) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileDropSequence: %w", ErrNotSupported)
+}
+
+// wrapTable returns the table name quoted for MySQL, quoting the namespace separately if the name is qualified.
+func (m *MySqlGrammar) wrapTable(table string) string {
+	namespace, name := SplitQualified(table)
+	if namespace == "" {
+		return "`" + name + "`"
+	}
+	return "`" + namespace + "`.`" + name + "`"
+}
+
+// WrapTable returns the table name quoted for MySQL, for statements written outside the grammar.
+func (m *MySqlGrammar) WrapTable(table string) (string, error) {
+	return m.wrapTable(table), nil
+}
+
 // CompileCreateTable returns the SQL for creating a table in MySQL.
 // It iterates over the definitions in the blueprint to build the table schema.
 func (m *MySqlGrammar) CompileCreateTable(b Blueprint) (string, error) {
@@ -193,6 +357,20 @@ func (m *MySqlGrammar) CompileCreateTable(b Blueprint) (string, error) {
 	sql = strings.TrimRight(sql, ",")
 	sql += fmt.Sprintf(") default character set %s collate '%s'", b.GetCharSet(), b.GetCollation())
 
+	options, err := m.CompileTableOptions(b.GetOptions())
+	if err != nil {
+		return "", err
+	}
+	sql += options
+
+	if b.GetPartitioning() != nil {
+		partitioning, err := b.GetPartitioning().Expression(m)
+		if err != nil {
+			return "", err
+		}
+		sql += partitioning
+	}
+
 	sql += ";\n"
 
 	// Compile child blueprints if any (e.g., foreign key constraints)
@@ -212,15 +390,25 @@ func (m *MySqlGrammar) CompileDropTable(table Blueprint) (string, error) {
 	return "", nil
 }
 
+// CompileRenameTable returns the SQL for renaming a table in MySQL.
+func (m *MySqlGrammar) CompileRenameTable(table Blueprint) (string, error) {
+	if table.GetRenameTo() == "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileRenameTable: new table name is required")
+	}
+	return " to " + m.wrapTable(table.GetRenameTo()), nil
+}
+
+// CompileTruncateTable returns the SQL for truncating a table in MySQL.
+func (m *MySqlGrammar) CompileTruncateTable(table Blueprint) (string, error) {
+	return "", nil
+}
+
 // CompileAlterTable returns the SQL for altering a table in MySQL.
-// It handles adding new columns or modifying existing columns.
+// It handles adding new columns or modifying existing columns, followed by the table options if any are set.
 func (m *MySqlGrammar) CompileAlterTable(table Blueprint) (string, error) {
 	var sql string
-	alterDirective := m.getDirective("alter")
-	for i, c := range table.Definitions() {
-		if i > 0 {
-			sql += alterDirective + " `" + table.GetTable() + "`"
-		}
+	prefix := m.getDirective("alter") + " " + m.wrapTable(table.GetTable())
+	for _, c := range table.Definitions() {
 		expression, err := c.Expression(m)
 		if err != nil {
 			return "", err
@@ -228,27 +416,69 @@ func (m *MySqlGrammar) CompileAlterTable(table Blueprint) (string, error) {
 
 		// Handle column addition specifically
 		if reflect.TypeOf(c) == reflect.TypeOf(&Column{}) {
-			sql += " add " + expression + ";\n"
-			continue
+			expression = " add " + expression
+		}
+
+		sql += prefix + strings.TrimRight(expression, ";") + ";\n"
+	}
+
+	if !table.GetOptions().IsEmpty() {
+		options, err := m.CompileTableOptions(table.GetOptions())
+		if err != nil {
+			return "", err
 		}
+		sql += prefix + options + ";\n"
+	}
 
-		sql += expression + ";\n"
+	if table.GetPartitioning() != nil {
+		partitioning, err := table.GetPartitioning().Expression(m)
+		if err != nil {
+			return "", err
+		}
+		sql += prefix + partitioning + ";\n"
+	}
 
-		// Compile child blueprints if any (e.g., foreign key constraints)
-		for _, cb := range table.Children() {
-			child, err := m.Build(cb)
-			if err != nil {
-				return "", err
-			}
-			sql += child + "\n"
+	// Compile child blueprints if any (e.g., foreign key constraints)
+	for _, cb := range table.Children() {
+		child, err := m.Build(cb)
+		if err != nil {
+			return "", err
 		}
+		sql += child + "\n"
 	}
 
-	sql = strings.ReplaceAll(sql, ";;", ";")
+	// The directive of the first statement is already written by Build.
+	sql = strings.TrimPrefix(sql, prefix)
 
 	return strings.TrimRight(sql, ";\n"), nil
 }
 
+// CompileTableOptions returns the SQL for the table options in MySQL.
+// Only the options that are set are rendered.
+func (m *MySqlGrammar) CompileTableOptions(options TableOptions) (string, error) {
+	var sql string
+	if options.Engine != "" {
+		sql += " engine=" + options.Engine
+	}
+	if options.RowFormat != "" {
+		sql += " row_format=" + options.RowFormat
+	}
+	if options.StartingValue > 0 {
+		sql += " auto_increment=" + strconv.Itoa(options.StartingValue)
+	}
+	if options.KeyBlockSize > 0 {
+		sql += " key_block_size=" + strconv.Itoa(options.KeyBlockSize)
+	}
+	if options.Comment != nil {
+		comment, err := options.Comment.Expression(m)
+		if err != nil {
+			return "", err
+		}
+		sql += " comment " + comment
+	}
+	return sql, nil
+}
+
 // CompileEnumValues returns the SQL for enum values in MySQL.
 // It constructs the enum values as a comma-separated list.
 func (m *MySqlGrammar) CompileEnumValues(e *EnumValues) (string, error) {
@@ -263,28 +493,78 @@ func (m *MySqlGrammar) CompileEnumValues(e *EnumValues) (string, error) {
 }
 
 // CompileCreateDatabase returns the SQL for creating a database in MySQL.
-// This function is not implemented yet.
-func (m *MySqlGrammar) CompileCreateDatabase(database string) (string, error) {
-	return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateDatabase not implemented")
+// It applies the character set and collation of the database, falling back to the grammar defaults.
+func (m *MySqlGrammar) CompileCreateDatabase(database *Database) (string, error) {
+	directive := "create database"
+	if database.Mode() == "createIfNotExists" {
+		directive += " if not exists"
+	}
+	return fmt.Sprintf("%s `%s` default character set %s collate '%s';", directive, database.GetName(), database.GetCharSet(), database.GetCollation()), nil
 }
 
 // CompileDropDatabase returns the SQL for dropping a database in MySQL.
-// This function is not implemented yet.
-func (m *MySqlGrammar) CompileDropDatabase(database string) (string, error) {
-	return "", fmt.Errorf("blackhole: MySQL grammar: CompileDropDatabase not implemented")
+func (m *MySqlGrammar) CompileDropDatabase(database *Database) (string, error) {
+	directive := "drop database"
+	if database.Mode() == "dropIfExists" {
+		directive += " if exists"
+	}
+	return fmt.Sprintf("%s `%s`;", directive, database.GetName()), nil
 }
 
 // CompileIndex returns the SQL for creating an index in MySQL.
-// It constructs the index name and optionally specifies the algorithm to use.
+// It constructs the index name and optionally specifies the algorithm and the full-text parser to use,
+// followed by the index comment and visibility. Expression key parts are rendered as functional key parts.
+// The text search language of the index is not supported by MySQL and is ignored.
+// Partial indexes are not supported by MySQL.
 func (m *MySqlGrammar) CompileIndex(i *Index) (string, error) {
 	var sql string
-	columnsForName := strings.Join(i.Columns, "_")
-	indexName := strings.TrimRight(fmt.Sprintf("%s_%s_%s", strings.Trim(i.Table, " "), strings.ReplaceAll(columnsForName, " ", "_"), i.Type), "_")
+	if i.Condition != "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileIndex: partial indexes: %w", ErrNotSupported)
+	}
+	if i.Language != "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileIndex: text search languages: %w", ErrNotSupported)
+	}
+	indexName := i.Name
+	if indexName == "" {
+		if len(i.Columns) == 0 {
+			return "", fmt.Errorf("blackhole: MySQL grammar: CompileIndex: name is required for expression indexes")
+		}
+		columnsForName := strings.Join(i.Columns, "_")
+		_, table := SplitQualified(strings.Trim(i.Table, " "))
+		indexName = strings.TrimRight(fmt.Sprintf("%s_%s_%s", table, strings.ReplaceAll(columnsForName, " ", "_"), i.Type), "_")
+	}
+	parts := i.ColumnsString()
+	for _, e := range i.Expressions {
+		if parts != "" {
+			parts += ", "
+		}
+		parts += "(" + e + ")"
+	}
 	using := ""
 	if i.Algorithm != IndexAlgorithmDefault {
 		using = fmt.Sprintf(" using %s", i.Algorithm)
 	}
-	sql = fmt.Sprintf(" add %s `%s`(%s)%s;", i.Type, indexName, i.ColumnsString(), using)
+	if i.Parser != "" {
+		if i.Type != IndexTypeFullText {
+			return "", fmt.Errorf("blackhole: MySQL grammar: CompileIndex: parser can only be set on fulltext indexes")
+		}
+		using += fmt.Sprintf(" with parser %s", i.Parser)
+	}
+	if i.Comment != nil {
+		comment, err := i.Comment.Expression(m)
+		if err != nil {
+			return "", err
+		}
+		using += " comment " + comment
+	}
+	if i.Visibility != IndexVisibilityDefault {
+		using += " " + string(i.Visibility)
+	}
+	if i.Type == IndexTypePrimary {
+		// Primary keys are always named PRIMARY in MySQL
+		return fmt.Sprintf(" add primary key (%s)%s;", parts, using), nil
+	}
+	sql = fmt.Sprintf(" add %s `%s`(%s)%s;", i.Type, indexName, parts, using)
 	return sql, nil
 }
 
@@ -292,11 +572,12 @@ func (m *MySqlGrammar) CompileIndex(i *Index) (string, error) {
 // It ensures that both referenced column and table are specified.
 func (m *MySqlGrammar) CompileForeignKey(f *ForeignKey) (string, error) {
 	var sql string
-	name := fmt.Sprintf("%s_%s_foreign", f.GetTable(), f.GetColumn())
+	_, table := SplitQualified(f.GetTable())
+	name := fmt.Sprintf("%s_%s_foreign", table, f.GetColumn())
 	if f.ReferencedColumn() == "" || f.ReferencedTable() == "" {
 		return "", fmt.Errorf("blackhole: MySQL grammar: CompileForeignKey: referenced column and table are required")
 	}
-	sql = fmt.Sprintf(" add constraint `%s` foreign key (`%s`) references `%s` (`%s`)", name, f.GetColumn(), f.ReferencedTable(), f.ReferencedColumn())
+	sql = fmt.Sprintf(" add constraint `%s` foreign key (`%s`) references %s (`%s`)", name, f.GetColumn(), m.wrapTable(f.ReferencedTable()), f.ReferencedColumn())
 	if f.GetOnDeleteAction() != nil {
 		sql += fmt.Sprintf(" on delete %s", *f.GetOnDeleteAction())
 	}
@@ -318,3 +599,261 @@ func (m *MySqlGrammar) CompileRenameColumn(r *RenameColumn) (string, error) {
 func (m *MySqlGrammar) CompileDropColumn(column string) (string, error) {
 	return fmt.Sprintf(" drop column `%s`;", column), nil
 }
+
+// CompileModifyColumn returns the SQL for changing the definition of a column in MySQL.
+// The new definition replaces the old one entirely, so it must carry every attribute the column keeps.
+func (m *MySqlGrammar) CompileModifyColumn(mc *ModifyColumn) (string, error) {
+	column, err := m.CompileColumn(mc.Column())
+	if err != nil {
+		return "", err
+	}
+	return " modify column " + column + ";", nil
+}
+
+// CompileCheck returns the SQL for adding a check constraint in MySQL.
+func (m *MySqlGrammar) CompileCheck(c *Check) (string, error) {
+	if c.GetName() == "" || c.GetExpression() == "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileCheck: constraint name and expression are required")
+	}
+	return fmt.Sprintf(" add constraint `%s` check (%s);", c.GetName(), c.GetExpression()), nil
+}
+
+// CompileDropCheck returns the SQL for dropping a check constraint in MySQL.
+func (m *MySqlGrammar) CompileDropCheck(name string) (string, error) {
+	return fmt.Sprintf(" drop check `%s`;", name), nil
+}
+
+// CompilePartitioning returns the SQL for partitioning a table in MySQL.
+// Range and list partitioning list their partitions, while hash and key partitioning only set the partition count.
+func (m *MySqlGrammar) CompilePartitioning(p *Partitioning) (string, error) {
+	sql := fmt.Sprintf(" partition by %s (%s)", p.Type, p.By)
+	switch p.Type {
+	case PartitionTypeRange, PartitionTypeList:
+		if len(p.Partitions) == 0 {
+			return "", fmt.Errorf("blackhole: MySQL grammar: CompilePartitioning: %s partitioning requires partitions", p.Type)
+		}
+		partitions, err := m.compilePartitions(p.Type, p.Partitions)
+		if err != nil {
+			return "", err
+		}
+		sql += " " + partitions
+	case PartitionTypeHash, PartitionTypeKey:
+		if p.Count > 0 {
+			sql += " partitions " + strconv.Itoa(p.Count)
+		}
+	default:
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompilePartitioning: invalid partition type given : %s", p.Type)
+	}
+	return sql, nil
+}
+
+// CompileAddPartition returns the SQL for adding partitions to a table in MySQL.
+func (m *MySqlGrammar) CompileAddPartition(a *AddPartition) (string, error) {
+	if len(a.Partitions) == 0 {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileAddPartition: partitions are required")
+	}
+	partitions, err := m.compilePartitions(a.Partitions[0].Type, a.Partitions)
+	if err != nil {
+		return "", err
+	}
+	return " add partition " + partitions + ";", nil
+}
+
+// CompileDropPartition returns the SQL for dropping partitions from a table in MySQL.
+func (m *MySqlGrammar) CompileDropPartition(d *DropPartition) (string, error) {
+	if len(d.Names) == 0 {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileDropPartition: partition names are required")
+	}
+	return " drop partition " + m.partitionNames(d.Names) + ";", nil
+}
+
+// CompileReorganizePartition returns the SQL for reorganizing partitions of a table in MySQL.
+func (m *MySqlGrammar) CompileReorganizePartition(r *ReorganizePartition) (string, error) {
+	if len(r.Names) == 0 || len(r.Into) == 0 {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileReorganizePartition: partition names and new partitions are required")
+	}
+	partitions, err := m.compilePartitions(r.Into[0].Type, r.Into)
+	if err != nil {
+		return "", err
+	}
+	return " reorganize partition " + m.partitionNames(r.Names) + " into " + partitions + ";", nil
+}
+
+// compilePartitions returns the parenthesized list of partition definitions of the given partition type.
+func (m *MySqlGrammar) compilePartitions(partitionType PartitionType, partitions []*Partition) (string, error) {
+	defs := make([]string, len(partitions))
+	for i, p := range partitions {
+		if p.Type != partitionType {
+			return "", fmt.Errorf("blackhole: MySQL grammar: partition `%s` is a %s partition, expected %s", p.Name, p.Type, partitionType)
+		}
+		switch p.Type {
+		case PartitionTypeRange:
+			defs[i] = fmt.Sprintf("partition `%s` values less than (%s)", p.Name, p.Bound)
+		case PartitionTypeList:
+			defs[i] = fmt.Sprintf("partition `%s` values in (%s)", p.Name, p.Bound)
+		default:
+			return "", fmt.Errorf("blackhole: MySQL grammar: partition `%s` has no bound for %s partitioning", p.Name, p.Type)
+		}
+	}
+	return "(" + strings.Join(defs, ", ") + ")", nil
+}
+
+// partitionNames returns the comma separated list of quoted partition names.
+func (m *MySqlGrammar) partitionNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = "`" + n + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// CompileCreateView returns the SQL for creating a view in MySQL.
+// It applies the algorithm and SQL security options of the view if they are set.
+func (m *MySqlGrammar) CompileCreateView(v *View) (string, error) {
+	if v.GetQuery() == "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateView: select query is required")
+	}
+	sql := "create"
+	if v.Mode() == "createOrReplace" {
+		sql += " or replace"
+	}
+	if v.GetOptions().Algorithm != "" {
+		sql += " algorithm=" + string(v.GetOptions().Algorithm)
+	}
+	if v.GetOptions().Security != "" {
+		sql += " sql security " + string(v.GetOptions().Security)
+	}
+	sql += fmt.Sprintf(" view %s as %s;", m.wrapTable(v.GetName()), strings.TrimRight(v.GetQuery(), "; \n"))
+	return sql, nil
+}
+
+// CompileDropView returns the SQL for dropping a view in MySQL.
+func (m *MySqlGrammar) CompileDropView(v *View) (string, error) {
+	return fmt.Sprintf("drop view %s;", m.wrapTable(v.GetName())), nil
+}
+
+// CompileCreateMaterializedView returns an error as MySQL has no materialized views.
+func (m *MySqlGrammar) CompileCreateMaterializedView(_ *View) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateMaterializedView: %w", ErrNotSupported)
+}
+
+// CompileRefreshMaterializedView returns an error as MySQL has no materialized views.
+func (m *MySqlGrammar) CompileRefreshMaterializedView(_ *View) (string, error) {
+	return "", fmt.Errorf("blackhole: MySQL grammar: CompileRefreshMaterializedView: %w", ErrNotSupported)
+}
+
+// CompileCreateTrigger returns the SQL for creating a trigger in MySQL.
+// The body is wrapped in a begin ... end block unless it already is one.
+func (m *MySqlGrammar) CompileCreateTrigger(t *Trigger) (string, error) {
+	if t.GetTiming() == TriggerInsteadOf {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateTrigger: instead of triggers: %w", ErrNotSupported)
+	}
+	if t.GetTiming() == "" || t.GetEvent() == "" || t.GetBody() == "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateTrigger: timing, event and body are required")
+	}
+	return fmt.Sprintf("create trigger `%s` %s %s on %s for each row %s;", t.GetName(), t.GetTiming(), t.GetEvent(), m.wrapTable(t.GetTable()), m.compoundBody(t.GetBody())), nil
+}
+
+// CompileDropTrigger returns the SQL for dropping a trigger in MySQL.
+func (m *MySqlGrammar) CompileDropTrigger(t *Trigger) (string, error) {
+	return fmt.Sprintf("drop trigger `%s`;", t.GetName()), nil
+}
+
+// CompileCreateRoutine returns the SQL for creating a stored procedure or function in MySQL.
+// The characteristics of the routine follow the parameter list and, for functions, the return type.
+func (m *MySqlGrammar) CompileCreateRoutine(r *Routine) (string, error) {
+	if r.GetBody() == "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateRoutine: body is required")
+	}
+	if r.GetKind() == RoutineFunction && r.GetReturns() == "" {
+		return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateRoutine: return type is required for functions")
+	}
+
+	params := make([]string, len(r.GetParameters()))
+	for i, p := range r.GetParameters() {
+		params[i] = fmt.Sprintf("`%s` %s", p.Name, p.DataType)
+		if r.GetKind() == RoutineProcedure && p.Mode != "" {
+			params[i] = string(p.Mode) + " " + params[i]
+		} else if r.GetKind() == RoutineFunction && p.Mode != "" && p.Mode != ParameterIn {
+			return "", fmt.Errorf("blackhole: MySQL grammar: CompileCreateRoutine: function parameter `%s` must be an input parameter", p.Name)
+		}
+	}
+
+	sql := fmt.Sprintf("create %s `%s`(%s)", r.GetKind(), r.GetName(), strings.Join(params, ", "))
+	if r.GetKind() == RoutineFunction {
+		sql += " returns " + r.GetReturns()
+	}
+
+	options := r.GetOptions()
+	if options.Comment != nil {
+		comment, err := options.Comment.Expression(m)
+		if err != nil {
+			return "", err
+		}
+		sql += " comment " + comment
+	}
+	if options.Deterministic {
+		sql += " deterministic"
+	}
+	if options.DataAccess != "" {
+		sql += " " + string(options.DataAccess)
+	}
+	if options.Security != "" {
+		sql += " sql security " + string(options.Security)
+	}
+
+	return sql + " " + m.compoundBody(r.GetBody()) + ";", nil
+}
+
+// CompileDropRoutine returns the SQL for dropping a stored procedure or function in MySQL.
+func (m *MySqlGrammar) CompileDropRoutine(r *Routine) (string, error) {
+	return fmt.Sprintf("drop %s `%s`;", r.GetKind(), r.GetName()), nil
+}
+
+// compoundBlockPattern matches a body that starts with a begin ... end block, optionally labeled.
+var compoundBlockPattern = regexp.MustCompile(`(?is)^(\w+\s*:\s*)?begin\b`)
+
+// compoundBody returns the body wrapped in a begin ... end block unless it already is one, labeled or not.
+func (m *MySqlGrammar) compoundBody(body string) string {
+	body = strings.TrimRight(strings.TrimSpace(body), ";")
+	if !compoundBlockPattern.MatchString(body) {
+		body = "begin\n" + body + ";\nend"
+	}
+	return body
+}
+
+// CompileCompoundStatement returns the statement wrapped in a temporary delimiter, as the mysql client
+// would otherwise end the statement at the first semicolon of its body.
+func (m *MySqlGrammar) CompileCompoundStatement(sql string) (string, error) {
+	return "delimiter $$\n" + strings.TrimSuffix(sql, ";") + "$$\ndelimiter ;", nil
+}
+
+// CompileTableListing returns the query listing the names of the tables of the current database in MySQL.
+func (m *MySqlGrammar) CompileTableListing() (string, error) {
+	return "select table_name from information_schema.tables where table_schema = database() and table_type = 'BASE TABLE' order by table_name;", nil
+}
+
+// CompileViewListing returns the query listing the names of the views of the current database in MySQL.
+func (m *MySqlGrammar) CompileViewListing() (string, error) {
+	return "select table_name from information_schema.views where table_schema = database() order by table_name;", nil
+}
+
+// CompileTableDefinition returns the query for the name and the create statement of a table in MySQL.
+func (m *MySqlGrammar) CompileTableDefinition(table string) (string, error) {
+	return fmt.Sprintf("show create table %s;", m.wrapTable(table)), nil
+}
+
+// CompileViewDefinition returns the query for the name and the create statement of a view in MySQL.
+func (m *MySqlGrammar) CompileViewDefinition(view string) (string, error) {
+	return fmt.Sprintf("show create view %s;", m.wrapTable(view)), nil
+}
+
+// CompileDisableForeignKeyConstraints returns the SQL disabling foreign key checks for the session in MySQL.
+func (m *MySqlGrammar) CompileDisableForeignKeyConstraints() (string, error) {
+	return "set foreign_key_checks = 0;", nil
+}
+
+// CompileEnableForeignKeyConstraints returns the SQL enabling foreign key checks for the session in MySQL.
+func (m *MySqlGrammar) CompileEnableForeignKeyConstraints() (string, error) {
+	return "set foreign_key_checks = 1;", nil
+}
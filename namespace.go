@@ -0,0 +1,61 @@
+package blackhole
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SplitQualified splits a namespace qualified name such as "billing.invoices" into its namespace and
+// unqualified name. The namespace is empty if the name is not qualified.
+func SplitQualified(name string) (namespace, unqualified string) {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// Namespace represents a namespace (a schema in Postgres, a database in MySQL) that tables live in.
+type Namespace struct {
+	mode    string
+	name    string
+	grammar *Grammar
+}
+
+// NewNamespace creates a new Namespace instance with the specified name.
+func NewNamespace(name string) *Namespace {
+	return &Namespace{
+		name: name,
+	}
+}
+
+// Grammar sets the grammar for the namespace.
+func (n *Namespace) Grammar(grammar *Grammar) {
+	n.grammar = grammar
+}
+
+// GetName returns the name of the namespace.
+func (n *Namespace) GetName() string {
+	return n.name
+}
+
+// Mode returns the mode (create, createIfNotExists, drop, dropIfExists) of the namespace.
+func (n *Namespace) Mode() string {
+	return n.mode
+}
+
+// setMode sets the mode of the namespace.
+func (n *Namespace) setMode(mode string) *Namespace {
+	n.mode = mode
+	return n
+}
+
+// Build builds the SQL statement for the namespace using the associated grammar.
+func (n *Namespace) Build() (string, error) {
+	switch n.mode {
+	case "create", "createIfNotExists":
+		return (*n.grammar).CompileCreateNamespace(n)
+	case "drop", "dropIfExists":
+		return (*n.grammar).CompileDropNamespace(n)
+	}
+	return "", fmt.Errorf("blackhole: invalid namespace mode given : %s", n.mode)
+}
@@ -0,0 +1,54 @@
+package blackhole
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// snakeCase converts a Go identifier such as "OrderItem" or "HTTPRequest" to snake case ("order_item", "http_request").
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			lowerBefore := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			acronymEnd := i > 0 && unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if lowerBefore || acronymEnd {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// modelName returns the snake cased name of the Go type of the model, dereferencing pointers.
+func modelName(model any) string {
+	t := reflect.TypeOf(model)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+	return snakeCase(t.Name())
+}
+
+// singularOf returns the singular form of a table name.
+func singularOf(table string) string {
+	return pluralize.NewClient().Singular(table)
+}
+
+// pivotSingulars returns the singular names of the two tables in alphabetical order,
+// which make up the conventional name of their pivot table when joined by an underscore.
+func pivotSingulars(tableA, tableB string) []string {
+	names := []string{singularOf(tableA), singularOf(tableB)}
+	sort.Strings(names)
+	return names
+}
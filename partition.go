@@ -0,0 +1,77 @@
+package blackhole
+
+import "strings"
+
+type PartitionType string
+
+const (
+	PartitionTypeRange PartitionType = "range"
+	PartitionTypeList  PartitionType = "list"
+	PartitionTypeHash  PartitionType = "hash"
+	PartitionTypeKey   PartitionType = "key"
+)
+
+// Partition represents a single partition definition of a partitioned table.
+// Bound is the raw SQL bound of the partition: the "less than" value of a range partition,
+// or the comma separated values of a list partition.
+type Partition struct {
+	Name  string
+	Type  PartitionType
+	Bound string
+}
+
+// RangePartition creates a range partition holding the rows below the given bound, e.g. "maxvalue".
+func RangePartition(name, bound string) *Partition {
+	return &Partition{Name: name, Type: PartitionTypeRange, Bound: bound}
+}
+
+// ListPartition creates a list partition holding the rows matching one of the given values.
+func ListPartition(name string, values ...string) *Partition {
+	return &Partition{Name: name, Type: PartitionTypeList, Bound: strings.Join(values, ", ")}
+}
+
+// Partitioning represents how the rows of a table are split into partitions.
+// By is the column list or expression the rows are partitioned by, and Count the number of
+// partitions of hash and key partitioning.
+type Partitioning struct {
+	Definition
+	Type       PartitionType
+	By         string
+	Count      int
+	Partitions []*Partition
+}
+
+func (p *Partitioning) Expression(grammar Grammar) (string, error) {
+	return grammar.CompilePartitioning(p)
+}
+
+// AddPartition represents the addition of partitions to a partitioned table.
+type AddPartition struct {
+	Definition
+	Partitions []*Partition
+}
+
+func (a *AddPartition) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileAddPartition(a)
+}
+
+// DropPartition represents the removal of partitions, and the rows they hold, from a partitioned table.
+type DropPartition struct {
+	Definition
+	Names []string
+}
+
+func (d *DropPartition) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileDropPartition(d)
+}
+
+// ReorganizePartition represents merging or splitting existing partitions into new ones.
+type ReorganizePartition struct {
+	Definition
+	Names []string
+	Into  []*Partition
+}
+
+func (r *ReorganizePartition) Expression(grammar Grammar) (string, error) {
+	return grammar.CompileReorganizePartition(r)
+}
@@ -52,6 +52,10 @@ func charColumn(name string, length int) *Column {
 	return NewColumn(name, ColumnTypeChar, length)
 }
 
+func jsonColumn(name string) *Column {
+	return NewColumn(name, ColumnTypeJson, 0)
+}
+
 func textColumn(name string) *Column {
 	return NewColumn(name, ColumnTypeText, 0)
 }
@@ -76,6 +80,18 @@ func timestampColumn(name string) *Column {
 	return NewColumn(name, ColumnTypeTimestamp, 0)
 }
 
+func timestampTzColumn(name string) *Column {
+	return NewColumn(name, ColumnTypeTimestampTz, 0)
+}
+
+func dateTimeTzColumn(name string) *Column {
+	return NewColumn(name, ColumnTypeDateTimeTz, 0)
+}
+
+func yearColumn(name string) *Column {
+	return NewColumn(name, ColumnTypeYear, 0)
+}
+
 func dateColumn(name string) *Column {
 	return NewColumn(name, ColumnTypeDate, 0)
 }
@@ -87,3 +103,7 @@ func timeColumn(name string) *Column {
 func dateTimeColumn(name string) *Column {
 	return NewColumn(name, ColumnTypeDateTime, 0)
 }
+
+func spatialColumn(name string, dataType ColumnType) *Column {
+	return NewColumn(name, dataType, 0)
+}
@@ -0,0 +1,150 @@
+package blackhole
+
+import "fmt"
+
+type RoutineKind string
+type ParameterMode string
+type DataAccess string
+
+// RoutineSecurity decides whose privileges a stored routine runs with.
+type RoutineSecurity string
+
+const (
+	RoutineProcedure RoutineKind = "procedure"
+	RoutineFunction  RoutineKind = "function"
+)
+
+const (
+	ParameterIn    ParameterMode = "in"
+	ParameterOut   ParameterMode = "out"
+	ParameterInOut ParameterMode = "inout"
+)
+
+const (
+	DataAccessContainsSQL DataAccess = "contains sql"
+	DataAccessNoSQL       DataAccess = "no sql"
+	DataAccessReadsSQL    DataAccess = "reads sql data"
+	DataAccessModifiesSQL DataAccess = "modifies sql data"
+)
+
+const (
+	RoutineSecurityDefiner RoutineSecurity = "definer"
+	RoutineSecurityInvoker RoutineSecurity = "invoker"
+)
+
+// RoutineParameter represents a parameter of a stored procedure or function.
+// DataType is the raw SQL type of the parameter, e.g. "bigint unsigned".
+type RoutineParameter struct {
+	Mode     ParameterMode
+	Name     string
+	DataType string
+}
+
+// Param creates an input parameter of the given type.
+func Param(name, dataType string) RoutineParameter {
+	return RoutineParameter{Mode: ParameterIn, Name: name, DataType: dataType}
+}
+
+// OutParam creates an output parameter of the given type.
+func OutParam(name, dataType string) RoutineParameter {
+	return RoutineParameter{Mode: ParameterOut, Name: name, DataType: dataType}
+}
+
+// InOutParam creates a parameter of the given type that is both read and written by the routine.
+func InOutParam(name, dataType string) RoutineParameter {
+	return RoutineParameter{Mode: ParameterInOut, Name: name, DataType: dataType}
+}
+
+// RoutineOptions holds the characteristics a stored routine is created with.
+type RoutineOptions struct {
+	Deterministic bool
+	DataAccess    DataAccess
+	Security      RoutineSecurity
+	Comment       *Comment
+}
+
+// Routine represents a stored procedure or function. The body may hold several statements.
+type Routine struct {
+	mode       string
+	kind       RoutineKind
+	name       string
+	parameters []RoutineParameter
+	returns    string
+	body       string
+	options    RoutineOptions
+	grammar    *Grammar
+}
+
+// NewRoutine creates a new Routine instance of the given kind. The return type only applies to functions.
+func NewRoutine(kind RoutineKind, name string, parameters []RoutineParameter, returns, body string, options RoutineOptions) *Routine {
+	return &Routine{
+		kind:       kind,
+		name:       name,
+		parameters: parameters,
+		returns:    returns,
+		body:       body,
+		options:    options,
+	}
+}
+
+// Grammar sets the grammar for the routine.
+func (r *Routine) Grammar(grammar *Grammar) {
+	r.grammar = grammar
+}
+
+// GetKind returns whether the routine is a procedure or a function.
+func (r *Routine) GetKind() RoutineKind {
+	return r.kind
+}
+
+// GetName returns the name of the routine.
+func (r *Routine) GetName() string {
+	return r.name
+}
+
+// GetParameters returns the parameters of the routine.
+func (r *Routine) GetParameters() []RoutineParameter {
+	return r.parameters
+}
+
+// GetReturns returns the return type of a function.
+func (r *Routine) GetReturns() string {
+	return r.returns
+}
+
+// GetBody returns the body of the routine.
+func (r *Routine) GetBody() string {
+	return r.body
+}
+
+// GetOptions returns the characteristics of the routine.
+func (r *Routine) GetOptions() RoutineOptions {
+	return r.options
+}
+
+// Mode returns the mode (create, drop) of the routine.
+func (r *Routine) Mode() string {
+	return r.mode
+}
+
+// setMode sets the mode of the routine.
+func (r *Routine) setMode(mode string) *Routine {
+	r.mode = mode
+	return r
+}
+
+// isCompound returns whether the statement of the routine may hold statement terminators of its own.
+func (r *Routine) isCompound() bool {
+	return r.mode == "create"
+}
+
+// Build builds the SQL statement for the routine using the associated grammar.
+func (r *Routine) Build() (string, error) {
+	switch r.mode {
+	case "create":
+		return (*r.grammar).CompileCreateRoutine(r)
+	case "drop":
+		return (*r.grammar).CompileDropRoutine(r)
+	}
+	return "", fmt.Errorf("blackhole: invalid routine mode given : %s", r.mode)
+}
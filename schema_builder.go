@@ -1,44 +1,330 @@
 package blackhole
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 )
 
+// Statement is a single buildable unit of a schema, such as a table blueprint or a database definition.
+type Statement interface {
+	Build() (string, error)
+}
+
 // Schema is a schema builder instance.
 type Schema struct {
-	grammar    Grammar
-	blueprints []*Blueprint
+	grammar            Grammar
+	statements         []Statement
+	reservedWordPolicy ReservedWordPolicy
+	dialects           []Dialect
+	warnings           []string
+	indexForeignKeys   bool
+	enumTypeTables     map[string][]string
+	err                error
 }
 
 // NewSchema creates a new schema instance.
 func NewSchema(grammar Grammar) *Schema {
 	return &Schema{
-		grammar:    grammar,
-		blueprints: []*Blueprint{},
+		grammar:            grammar,
+		statements:         []Statement{},
+		reservedWordPolicy: ReservedWordsWarn,
 	}
 }
 
-// Create a new table on the schema.
+// Create a new table on the schema. The build fails on the database if the table already exists.
 func (s *Schema) Create(name string, callback func(*Blueprint)) *Schema {
 	bp := s.addNewBlueprint(name)
 	bp.Create(callback)
+	s.trackEnumTypes(bp)
 	return s
 }
 
-// Alter an existing table on the schema.
-func (s *Schema) Alter(name string, callback func(*Blueprint)) *Schema {
+// CreateFromStruct creates a new table on the schema with a column for every field of the struct v.
+// See Blueprint.FromStruct for how fields are mapped; a struct that cannot be mapped fails the build.
+func (s *Schema) CreateFromStruct(table string, v any) *Schema {
+	return s.Create(table, func(bp *Blueprint) {
+		if err := bp.FromStruct(v); err != nil && s.err == nil {
+			s.err = err
+		}
+	})
+}
+
+// CreateIfNotExists creates a new table on the schema unless it already exists.
+func (s *Schema) CreateIfNotExists(name string, callback func(*Blueprint)) *Schema {
 	bp := s.addNewBlueprint(name)
+	bp.CreateIfNotExists(callback)
+	s.trackEnumTypes(bp)
+	return s
+}
+
+// Pivot creates the conventionally named pivot table of a many-to-many relation between the two tables,
+// e.g. "role_user" for "users" and "roles", with a foreign key to each table and a composite primary key.
+// Extra columns can be added to the pivot table through the optional callback. A table cannot be pivoted with
+// itself, as both foreign keys would get the same column; create such a table with distinct columns through Create.
+func (s *Schema) Pivot(tableA, tableB string, callback ...func(*Blueprint)) *Schema {
+	if singularOf(tableA) == singularOf(tableB) {
+		if s.err == nil {
+			s.err = fmt.Errorf("blackhole: pivot of %q with itself: both foreign keys would be named %s_id", tableA, singularOf(tableA))
+		}
+		return s
+	}
+	singulars := pivotSingulars(tableA, tableB)
+	return s.Create(strings.Join(singulars, "_"), func(bp *Blueprint) {
+		columns := make([]string, len(singulars))
+		tables := map[string]string{singularOf(tableA): tableA, singularOf(tableB): tableB}
+		for i, singular := range singulars {
+			fk, col := bp.ForeignId(singular + "_id")
+			fk.On(tables[singular], "id").CascadeOnDelete()
+			col.NotNull()
+			columns[i] = col.GetName()
+		}
+		bp.Primary(columns...)
+		for _, cb := range callback {
+			cb(bp)
+		}
+	})
+}
+
+// Alter an existing table on the schema. Values added to named enum types through Blueprint.AddEnumValues
+// are added ahead of the other changes.
+func (s *Schema) Alter(name string, callback func(*Blueprint)) *Schema {
+	bp := NewBlueprint(name)
+	bp.Grammar(&s.grammar)
 	bp.Alter(callback)
+	for _, e := range bp.enumTypes {
+		e.Grammar(&s.grammar)
+		s.addStatement(e)
+	}
+	if len(bp.enumTypes) == 0 || !bp.isEmpty() || bp.err != nil {
+		s.addBlueprint(bp)
+	}
 	return s
 }
 
-// Drop an existing table on the schema.
+// Drop an existing table on the schema. The build fails on the database if the table does not exist.
+// Enum types the table was the last user of are dropped along with it, see CreateEnumType.
 func (s *Schema) Drop(name string) *Schema {
 	bp := s.addNewBlueprint(name)
 	bp.Drop(func(blueprint *Blueprint) {})
+	s.dropUnusedEnumTypes(name, "drop")
+	return s
+}
+
+// DropIfExists drops a table on the schema if it exists.
+// Enum types the table was the last user of are dropped along with it, see CreateEnumType.
+func (s *Schema) DropIfExists(name string) *Schema {
+	bp := s.addNewBlueprint(name)
+	bp.DropIfExists(func(blueprint *Blueprint) {})
+	s.dropUnusedEnumTypes(name, "dropIfExists")
+	return s
+}
+
+// Rename an existing table on the schema.
+func (s *Schema) Rename(from, to string) *Schema {
+	bp := s.addNewBlueprint(from)
+	bp.Rename(to)
+	for enumType, tables := range s.enumTypeTables {
+		for i, table := range tables {
+			if table == from {
+				s.enumTypeTables[enumType][i] = to
+			}
+		}
+	}
+	return s
+}
+
+// Truncate removes all rows of an existing table on the schema.
+func (s *Schema) Truncate(name string) *Schema {
+	bp := s.addNewBlueprint(name)
+	bp.Truncate()
+	return s
+}
+
+// CreateDatabase creates a new database with the given options.
+func (s *Schema) CreateDatabase(name string, options DatabaseOptions) *Schema {
+	s.addStatement(s.newDatabase(name, options).setMode("create"))
+	return s
+}
+
+// CreateDatabaseIfNotExists creates a new database with the given options unless it already exists.
+func (s *Schema) CreateDatabaseIfNotExists(name string, options DatabaseOptions) *Schema {
+	s.addStatement(s.newDatabase(name, options).setMode("createIfNotExists"))
+	return s
+}
+
+// DropDatabase drops an existing database.
+func (s *Schema) DropDatabase(name string) *Schema {
+	s.addStatement(s.newDatabase(name, DatabaseOptions{}).setMode("drop"))
+	return s
+}
+
+// DropDatabaseIfExists drops a database if it exists.
+func (s *Schema) DropDatabaseIfExists(name string) *Schema {
+	s.addStatement(s.newDatabase(name, DatabaseOptions{}).setMode("dropIfExists"))
+	return s
+}
+
+// CreateView creates a new view defined by the select query.
+func (s *Schema) CreateView(name, query string, options ViewOptions) *Schema {
+	s.addStatement(s.newView(name, query, options).setMode("create"))
+	return s
+}
+
+// CreateOrReplaceView creates a new view defined by the select query, replacing it if it already exists.
+func (s *Schema) CreateOrReplaceView(name, query string, options ViewOptions) *Schema {
+	s.addStatement(s.newView(name, query, options).setMode("createOrReplace"))
+	return s
+}
+
+// DropView drops an existing view.
+func (s *Schema) DropView(name string) *Schema {
+	s.addStatement(s.newView(name, "", ViewOptions{}).setMode("drop"))
+	return s
+}
+
+// CreateMaterializedView creates a new materialized view defined by the select query, for grammars that support them.
+func (s *Schema) CreateMaterializedView(name, query string) *Schema {
+	s.addStatement(s.newView(name, query, ViewOptions{}).setMode("createMaterialized"))
+	return s
+}
+
+// RefreshMaterializedView refreshes the rows of an existing materialized view, for grammars that support them.
+func (s *Schema) RefreshMaterializedView(name string) *Schema {
+	s.addStatement(s.newView(name, "", ViewOptions{}).setMode("refreshMaterialized"))
+	return s
+}
+
+// CreateTrigger creates a row level trigger on the table, firing the body at the given timing of the event.
+func (s *Schema) CreateTrigger(name, table string, timing TriggerTiming, event TriggerEvent, body string) *Schema {
+	s.addStatement(s.newTrigger(name, table, timing, event, body).setMode("create"))
+	return s
+}
+
+// DropTrigger drops an existing trigger from the table.
+func (s *Schema) DropTrigger(name, table string) *Schema {
+	s.addStatement(s.newTrigger(name, table, "", "", "").setMode("drop"))
+	return s
+}
+
+// CreateProcedure creates a stored procedure with the given parameters, body and characteristics.
+func (s *Schema) CreateProcedure(name string, parameters []RoutineParameter, body string, options RoutineOptions) *Schema {
+	s.addStatement(s.newRoutine(RoutineProcedure, name, parameters, "", body, options).setMode("create"))
+	return s
+}
+
+// CreateFunction creates a stored function with the given parameters, return type, body and characteristics.
+func (s *Schema) CreateFunction(name string, parameters []RoutineParameter, returns, body string, options RoutineOptions) *Schema {
+	s.addStatement(s.newRoutine(RoutineFunction, name, parameters, returns, body, options).setMode("create"))
+	return s
+}
+
+// DropProcedure drops an existing stored procedure.
+func (s *Schema) DropProcedure(name string) *Schema {
+	s.addStatement(s.newRoutine(RoutineProcedure, name, nil, "", "", RoutineOptions{}).setMode("drop"))
+	return s
+}
+
+// DropFunction drops an existing stored function.
+func (s *Schema) DropFunction(name string) *Schema {
+	s.addStatement(s.newRoutine(RoutineFunction, name, nil, "", "", RoutineOptions{}).setMode("drop"))
+	return s
+}
+
+// CreateNamespace creates a new namespace that tables can be created in.
+func (s *Schema) CreateNamespace(name string) *Schema {
+	s.addStatement(s.newNamespace(name).setMode("create"))
+	return s
+}
+
+// CreateNamespaceIfNotExists creates a new namespace unless it already exists.
+func (s *Schema) CreateNamespaceIfNotExists(name string) *Schema {
+	s.addStatement(s.newNamespace(name).setMode("createIfNotExists"))
+	return s
+}
+
+// DropNamespace drops an existing namespace.
+func (s *Schema) DropNamespace(name string) *Schema {
+	s.addStatement(s.newNamespace(name).setMode("drop"))
+	return s
+}
+
+// DropNamespaceIfExists drops a namespace if it exists.
+func (s *Schema) DropNamespaceIfExists(name string) *Schema {
+	s.addStatement(s.newNamespace(name).setMode("dropIfExists"))
+	return s
+}
+
+// CreateEnumType creates a named enum type, for grammars that support them, that columns of several tables can
+// be of through Blueprint.EnumType. The type is dropped along with the last table created on this schema that
+// uses it; a type used by tables created elsewhere is dropped with DropEnumType.
+func (s *Schema) CreateEnumType(name string, values ...string) *Schema {
+	s.addStatement(s.newEnumType(name, values...).setMode("create"))
+	return s
+}
+
+// AddEnumTypeValues adds values to an existing enum type, for grammars that support them.
+func (s *Schema) AddEnumTypeValues(name string, values ...string) *Schema {
+	s.addStatement(s.newEnumType(name, values...).setMode("alter"))
 	return s
 }
 
+// DropEnumType drops an existing enum type, for grammars that support them.
+func (s *Schema) DropEnumType(name string) *Schema {
+	delete(s.enumTypeTables, name)
+	s.addStatement(s.newEnumType(name).setMode("drop"))
+	return s
+}
+
+// CreateSequence creates a new sequence, for grammars that support them.
+func (s *Schema) CreateSequence(name string, options SequenceOptions) *Schema {
+	s.addStatement(s.newSequence(name, options).setMode("create"))
+	return s
+}
+
+// AlterSequence changes the options of an existing sequence, for grammars that support them.
+func (s *Schema) AlterSequence(name string, options SequenceOptions) *Schema {
+	s.addStatement(s.newSequence(name, options).setMode("alter"))
+	return s
+}
+
+// DropSequence drops an existing sequence, for grammars that support them.
+func (s *Schema) DropSequence(name string) *Schema {
+	s.addStatement(s.newSequence(name, SequenceOptions{}).setMode("drop"))
+	return s
+}
+
+// ReservedWords sets how table and column names that are reserved words are handled on build.
+// The names are checked against the given dialects, or against the grammar's dialect if none are given.
+func (s *Schema) ReservedWords(policy ReservedWordPolicy, dialects ...Dialect) *Schema {
+	s.reservedWordPolicy = policy
+	s.dialects = dialects
+	return s
+}
+
+// IndexForeignKeys sets whether an index is created on build for every foreign key column
+// that is not already the leading column of another index of its table.
+func (s *Schema) IndexForeignKeys(enabled bool) *Schema {
+	s.indexForeignKeys = enabled
+	return s
+}
+
+// Blueprints returns the table blueprints of the schema in the order they were added.
+func (s *Schema) Blueprints() []*Blueprint {
+	var blueprints []*Blueprint
+	for _, st := range s.statements {
+		if bp, ok := st.(*Blueprint); ok {
+			blueprints = append(blueprints, bp)
+		}
+	}
+	return blueprints
+}
+
+// Warnings returns the warnings raised during the last build.
+func (s *Schema) Warnings() []string {
+	return s.warnings
+}
+
 func (s *Schema) addNewBlueprint(table string) *Blueprint {
 	bp := NewBlueprint(table)
 	bp.Grammar(&s.grammar)
@@ -48,23 +334,274 @@ func (s *Schema) addNewBlueprint(table string) *Blueprint {
 }
 
 func (s *Schema) addBlueprint(bp *Blueprint) {
-	s.blueprints = append(s.blueprints, bp)
+	s.addStatement(bp)
+}
+
+func (s *Schema) newDatabase(name string, options DatabaseOptions) *Database {
+	db := NewDatabase(name, options)
+	db.Grammar(&s.grammar)
+	return db
+}
+
+func (s *Schema) newView(name, query string, options ViewOptions) *View {
+	v := NewView(name, query, options)
+	v.Grammar(&s.grammar)
+	return v
+}
+
+func (s *Schema) newTrigger(name, table string, timing TriggerTiming, event TriggerEvent, body string) *Trigger {
+	t := NewTrigger(name, table, timing, event, body)
+	t.Grammar(&s.grammar)
+	return t
+}
+
+func (s *Schema) newRoutine(kind RoutineKind, name string, parameters []RoutineParameter, returns, body string, options RoutineOptions) *Routine {
+	r := NewRoutine(kind, name, parameters, returns, body, options)
+	r.Grammar(&s.grammar)
+	return r
+}
+
+func (s *Schema) newEnumType(name string, values ...string) *EnumType {
+	e := NewEnumType(name, values...)
+	e.Grammar(&s.grammar)
+	return e
+}
+
+// trackEnumTypes records the created table as a user of the enum types its columns are of.
+func (s *Schema) trackEnumTypes(bp *Blueprint) {
+	for _, d := range bp.Definitions() {
+		c, ok := d.(*Column)
+		if !ok || c.GetEnumType() == "" {
+			continue
+		}
+		if s.enumTypeTables == nil {
+			s.enumTypeTables = map[string][]string{}
+		}
+		if !slices.Contains(s.enumTypeTables[c.GetEnumType()], bp.GetTable()) {
+			s.enumTypeTables[c.GetEnumType()] = append(s.enumTypeTables[c.GetEnumType()], bp.GetTable())
+		}
+	}
+}
+
+// dropUnusedEnumTypes forgets the dropped table as a user of enum types, and drops the types it was the last user of.
+func (s *Schema) dropUnusedEnumTypes(table, mode string) {
+	var unused []string
+	for enumType, tables := range s.enumTypeTables {
+		i := slices.Index(tables, table)
+		if i < 0 {
+			continue
+		}
+		s.enumTypeTables[enumType] = slices.Delete(tables, i, i+1)
+		if len(s.enumTypeTables[enumType]) == 0 {
+			delete(s.enumTypeTables, enumType)
+			unused = append(unused, enumType)
+		}
+	}
+	slices.Sort(unused)
+	for _, enumType := range unused {
+		s.addStatement(s.newEnumType(enumType).setMode(mode))
+	}
+}
+
+func (s *Schema) newNamespace(name string) *Namespace {
+	n := NewNamespace(name)
+	n.Grammar(&s.grammar)
+	return n
+}
+
+func (s *Schema) newSequence(name string, options SequenceOptions) *Sequence {
+	seq := NewSequence(name, options)
+	seq.Grammar(&s.grammar)
+	return seq
+}
+
+func (s *Schema) addStatement(st Statement) {
+	s.statements = append(s.statements, st)
+}
+
+// orderStatements moves every created enum type up to just before the first table using it, and every created
+// view down to just after the last table or view it references that is created in the same schema, keeping the
+// order of the other statements as written.
+func (s *Schema) orderStatements() {
+	for i := 0; i < len(s.statements); i++ {
+		e, ok := s.statements[i].(*EnumType)
+		if !ok || e.Mode() != "create" {
+			continue
+		}
+		first := slices.IndexFunc(s.statements[:i], func(st Statement) bool {
+			bp, ok := st.(*Blueprint)
+			return ok && bp.usesEnumType(e.GetName())
+		})
+		if first >= 0 {
+			s.statements = slices.Insert(slices.Delete(s.statements, i, i+1), first, Statement(e))
+		}
+	}
+
+	dependencies := map[Statement][]Statement{}
+	for _, st := range s.statements {
+		v, ok := st.(*View)
+		if !ok || !v.isCreating() {
+			continue
+		}
+		for _, dep := range s.statements {
+			if name, ok := createdName(dep); ok && dep != st && v.References(name) {
+				dependencies[st] = append(dependencies[st], dep)
+			}
+		}
+	}
+
+	ordered := make([]Statement, 0, len(s.statements))
+	emitted := map[Statement]bool{}
+	var pending []Statement
+	ready := func(st Statement) bool {
+		for _, dep := range dependencies[st] {
+			if !emitted[dep] {
+				return false
+			}
+		}
+		return true
+	}
+	emit := func(st Statement) {
+		ordered = append(ordered, st)
+		emitted[st] = true
+		// Emitting a statement may complete the dependencies of the views held back so far.
+		for released := true; released; {
+			released = false
+			for i, view := range pending {
+				if ready(view) {
+					pending = append(pending[:i], pending[i+1:]...)
+					ordered = append(ordered, view)
+					emitted[view] = true
+					released = true
+					break
+				}
+			}
+		}
+	}
+
+	for _, st := range s.statements {
+		if ready(st) {
+			emit(st)
+		} else {
+			pending = append(pending, st)
+		}
+	}
+	// Views that reference each other cannot be ordered, they keep their relative order at the end.
+	s.statements = append(ordered, pending...)
+}
+
+// createdName returns the name of the table or view the statement creates, if it creates one.
+func createdName(st Statement) (string, bool) {
+	switch st := st.(type) {
+	case *Blueprint:
+		return st.GetTable(), st.isCreating()
+	case *View:
+		return st.GetName(), st.isCreating()
+	}
+	return "", false
+}
+
+// compoundStatement is implemented by statements whose SQL may hold statement terminators of its own,
+// such as trigger bodies, so it can neither be split nor joined on them.
+type compoundStatement interface {
+	isCompound() bool
+}
+
+// splitStatement is implemented by statements that build into several SQL statements, such as blueprints with
+// child blueprints, and can build each of them on its own.
+type splitStatement interface {
+	buildStatements() ([]string, error)
+}
+
+// compiledStatement is the SQL built from a single schema statement, along with the individual SQL statements
+// it consists of when it builds into several.
+type compiledStatement struct {
+	sql      string
+	parts    []string
+	compound bool
+}
+
+// compile prepares, validates and builds every statement of the schema in execution order.
+func (s *Schema) compile() ([]compiledStatement, error) {
+	if s.err != nil {
+		err := s.err
+		s.err = nil
+		s.statements = []Statement{}
+		return nil, err
+	}
+	if s.indexForeignKeys {
+		for _, st := range s.statements {
+			if bp, ok := st.(*Blueprint); ok {
+				bp.indexForeignKeys()
+			}
+		}
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	s.orderStatements()
+
+	compiled := make([]compiledStatement, 0, len(s.statements))
+	for _, st := range s.statements {
+		sql, err := st.Build()
+		if err != nil {
+			return nil, err
+		}
+		c, ok := st.(compoundStatement)
+		compiledSt := compiledStatement{sql: sql, compound: ok && c.isCompound()}
+		if sp, ok := st.(splitStatement); ok {
+			if compiledSt.parts, err = sp.buildStatements(); err != nil {
+				return nil, err
+			}
+		}
+		compiled = append(compiled, compiledSt)
+	}
+	s.statements = []Statement{}
+	return compiled, nil
 }
 
 // Build the schema into a SQL string.
+// Compound statements are delimited the way the grammar's command line client expects, so the result can be run as a script.
 func (s *Schema) Build() (string, error) {
 	var result string
-	for _, bp := range s.blueprints {
-		sql, err := bp.Build()
-		if err != nil {
-			return "", err
+	compiled, err := s.compile()
+	if err != nil {
+		return "", err
+	}
+	for _, c := range compiled {
+		sql := c.sql
+		if c.compound {
+			sql, err = s.grammar.CompileCompoundStatement(sql)
+			if err != nil {
+				return "", err
+			}
 		}
 		result += sql + "\n"
 	}
-	s.blueprints = []*Blueprint{}
 	return strings.TrimRight(result, "\n"), nil
 }
 
+// Statements builds the schema into its individual SQL statements, in execution order.
+// Each statement can be executed on its own, so compound statements are returned whole and without delimiters.
+func (s *Schema) Statements() ([]string, error) {
+	var statements []string
+	compiled, err := s.compile()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range compiled {
+		switch {
+		case c.compound:
+			statements = append(statements, c.sql)
+		case c.parts != nil:
+			statements = append(statements, c.parts...)
+		default:
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(c.sql), ";")+";")
+		}
+	}
+	return statements, nil
+}
+
 // MySQL is a MySQL grammar instance.
 var MySQL = NewMySqlGrammar()
 
@@ -1,7 +1,14 @@
 package blackhole
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestMySQLGrammar(t *testing.T) {
@@ -26,7 +33,7 @@ func TestMySQLGrammar(t *testing.T) {
 		table.Collate("utf8mb4_unicode_ci")
 	})
 
-	expectedSQL := "create table if not exists `builder_test`(`id` bigint unsigned not null auto_increment primary key,`test_string_column` varchar(255) not null,`test_default` varchar(255) not null default 'default_value',`test_enum` enum('a','b','c') not null default 'a',`created_at` timestamp not null default CURRENT_TIMESTAMP,`updated_at` timestamp not null default CURRENT_TIMESTAMP on update CURRENT_TIMESTAMP) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `builder_test` add unique `builder_test_test_string_column_unique`(`test_string_column`);\nalter table `builder_test` add index `builder_test_test_default_index`(`test_default`) using btree;"
+	expectedSQL := "create table `builder_test`(`id` bigint unsigned not null auto_increment primary key,`test_string_column` varchar(255) not null,`test_default` varchar(255) not null default 'default_value',`test_enum` enum('a','b','c') not null default 'a',`created_at` timestamp not null default CURRENT_TIMESTAMP,`updated_at` timestamp not null default CURRENT_TIMESTAMP on update CURRENT_TIMESTAMP) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `builder_test` add unique `builder_test_test_string_column_unique`(`test_string_column`);\nalter table `builder_test` add index `builder_test_test_default_index`(`test_default`) using btree;"
 	generatedSQL, err := schema.Build()
 
 	if err != nil {
@@ -53,7 +60,7 @@ func TestSchema_Create_WithMySQLGrammar(t *testing.T) {
 				bp.String("password", 255).NotNull()
 				bp.Int("age").IndexUsing(IndexAlgorithmBTree)
 			},
-			expected: "create table if not exists `users`(`id` bigint unsigned not null auto_increment primary key,`username` varchar(255) not null,`password` varchar(255) not null,`age` integer(11)) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `users` add unique `users_username_unique`(`username`);\nalter table `users` add index `users_age_index`(`age`) using btree;",
+			expected: "create table `users`(`id` bigint unsigned not null auto_increment primary key,`username` varchar(255) not null,`password` varchar(255) not null,`age` integer(11)) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `users` add unique `users_username_unique`(`username`);\nalter table `users` add index `users_age_index`(`age`) using btree;",
 		},
 		{
 			name: "posts",
@@ -64,7 +71,7 @@ func TestSchema_Create_WithMySQLGrammar(t *testing.T) {
 				foreign.CascadeOnDelete()
 				userId.Nullable()
 			},
-			expected: "create table if not exists `posts`(`id` bigint unsigned not null auto_increment primary key,`title` varchar(255) not null,`user_id` bigint unsigned null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `posts` add constraint `posts_user_id_foreign` foreign key (`user_id`) references `users` (`id`) on delete cascade;",
+			expected: "create table `posts`(`id` bigint unsigned not null auto_increment primary key,`title` varchar(255) not null,`user_id` bigint unsigned null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `posts` add constraint `posts_user_id_foreign` foreign key (`user_id`) references `users` (`id`) on delete cascade;",
 		},
 		{
 			name: "posts",
@@ -76,7 +83,114 @@ func TestSchema_Create_WithMySQLGrammar(t *testing.T) {
 				userId.Nullable()
 				foreign.On("users", "id")
 			},
-			expected: "create table if not exists `posts`(`id` bigint unsigned not null auto_increment primary key,`title` varchar(255) not null,`user_id` bigint unsigned null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `posts` add constraint `posts_user_id_foreign` foreign key (`user_id`) references `users` (`id`) on delete cascade;",
+			expected: "create table `posts`(`id` bigint unsigned not null auto_increment primary key,`title` varchar(255) not null,`user_id` bigint unsigned null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `posts` add constraint `posts_user_id_foreign` foreign key (`user_id`) references `users` (`id`) on delete cascade;",
+		},
+		{
+			name: "invoices",
+			callback: func(bp *Blueprint) {
+				bp.Id()
+				bp.Engine("InnoDB")
+				bp.RowFormat("dynamic")
+				bp.StartingValue(1000)
+				bp.KeyBlockSize(8)
+				bp.Comment("customer invoices")
+			},
+			expected: "create table `invoices`(`id` bigint unsigned not null auto_increment primary key) default character set utf8mb4 collate 'utf8mb4_unicode_ci' engine=InnoDB row_format=dynamic auto_increment=1000 key_block_size=8 comment 'customer invoices';",
+		},
+		{
+			name: "products",
+			callback: func(bp *Blueprint) {
+				bp.Int("price").Check("price >= 0")
+				bp.Int("discount")
+				bp.Check("products_discount_check", "discount <= price")
+			},
+			expected: "create table `products`(`price` integer(11) check (price >= 0),`discount` integer(11)) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `products` add constraint `products_discount_check` check (discount <= price);",
+		},
+		{
+			name: "people",
+			callback: func(bp *Blueprint) {
+				bp.String("first_name", 100)
+				bp.String("last_name", 100)
+				bp.String("full_name", 201).VirtualAs("concat(first_name, ' ', last_name)")
+				bp.String("country", 2).StoredAs("payload->>'$.country'").NotNull()
+			},
+			expected: "create table `people`(`first_name` varchar(100),`last_name` varchar(100),`full_name` varchar(201) generated always as (concat(first_name, ' ', last_name)) virtual,`country` varchar(2) generated always as (payload->>'$.country') stored not null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';",
+		},
+		{
+			name: "delivery_zones",
+			callback: func(bp *Blueprint) {
+				bp.Id()
+				bp.MultiPolygon("area").SRID(4326).NotNull()
+				bp.Point("center").Nullable()
+				bp.SpatialIndex("area")
+			},
+			expected: "create table `delivery_zones`(`id` bigint unsigned not null auto_increment primary key,`area` multipolygon srid 4326 not null,`center` point null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `delivery_zones` add spatial `delivery_zones_area_spatial`(`area`);",
+		},
+		{
+			name: "articles",
+			callback: func(bp *Blueprint) {
+				bp.Id()
+				bp.String("title", 255)
+				bp.Text("body")
+				bp.FullText("title", "body").WithParser("ngram")
+			},
+			expected: "create table `articles`(`id` bigint unsigned not null auto_increment primary key,`title` varchar(255),`body` text) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `articles` add fulltext `articles_title_body_fulltext`(`title`, `body`) with parser ngram;",
+		},
+		{
+			name: "events",
+			callback: func(bp *Blueprint) {
+				bp.BigInt("id").Unsigned().AutoIncrement()
+				bp.Date("occurred_on").NotNull()
+				bp.PartitionByRange("to_days(occurred_on)",
+					RangePartition("p2026_01", "to_days('2026-02-01')"),
+					RangePartition("p_future", "maxvalue"),
+				)
+			},
+			expected: "create table `events`(`id` bigint unsigned auto_increment,`occurred_on` date not null) default character set utf8mb4 collate 'utf8mb4_unicode_ci' partition by range (to_days(occurred_on)) (partition `p2026_01` values less than (to_days('2026-02-01')), partition `p_future` values less than (maxvalue));",
+		},
+		{
+			name: "shards",
+			callback: func(bp *Blueprint) {
+				bp.Int("region")
+				bp.PartitionByList("region", ListPartition("p_eu", "1", "2"), ListPartition("p_us", "3"))
+			},
+			expected: "create table `shards`(`region` integer(11)) default character set utf8mb4 collate 'utf8mb4_unicode_ci' partition by list (region) (partition `p_eu` values in (1, 2), partition `p_us` values in (3));",
+		},
+		{
+			name: "sessions",
+			callback: func(bp *Blueprint) {
+				bp.Int("user_id")
+				bp.PartitionByHash("user_id", 8)
+			},
+			expected: "create table `sessions`(`user_id` integer(11)) default character set utf8mb4 collate 'utf8mb4_unicode_ci' partition by hash (user_id) partitions 8;",
+		},
+		{
+			name: "audit_log",
+			callback: func(bp *Blueprint) {
+				bp.TimestampTz("seen_at").Precision(3)
+				bp.DateTimeTz("scheduled_for")
+				bp.Date("logged_on").Precision(3)
+				bp.Year("fiscal_year").Precision(3)
+				bp.TimestampsTz(6)
+				bp.SoftDeletesTz(6)
+			},
+			expected: "create table `audit_log`(`seen_at` timestamp(3),`scheduled_for` datetime,`logged_on` date,`fiscal_year` year,`created_at` timestamp(6) not null default CURRENT_TIMESTAMP(6),`updated_at` timestamp(6) not null default CURRENT_TIMESTAMP(6) on update CURRENT_TIMESTAMP(6),`deleted_at` timestamp(6) null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';",
+		},
+		{
+			name: "imports",
+			callback: func(bp *Blueprint) {
+				bp.NullableTimestamps()
+				bp.SoftDeletes()
+			},
+			expected: "create table `imports`(`created_at` timestamp null,`updated_at` timestamp null,`deleted_at` timestamp null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';",
+		},
+		{
+			name: "tokens",
+			callback: func(bp *Blueprint) {
+				bp.String("name", 255)
+				bp.String("token", 64).CharSet("ascii").Collation("ascii_bin").NotNull()
+			},
+			expected: "create table `tokens`(`name` varchar(255),`token` varchar(64) character set ascii collate 'ascii_bin' not null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';",
 		},
 	}
 
@@ -113,6 +227,73 @@ func TestSchema_Alter_WithMySQLGrammar(t *testing.T) {
 			},
 			expected: "alter table `users` add `created_at` timestamp not null default CURRENT_TIMESTAMP;\nalter table `users` add `updated_at` timestamp not null default CURRENT_TIMESTAMP on update CURRENT_TIMESTAMP;\nalter table `users` rename column `name` to `full_name`;\nalter table `users` drop column `email`;",
 		},
+		{
+			name: "invoices",
+			callback: func(bp *Blueprint) {
+				bp.String("number", 32)
+				bp.Engine("InnoDB")
+				bp.Comment("customer invoices")
+			},
+			expected: "alter table `invoices` add `number` varchar(32);\nalter table `invoices` engine=InnoDB comment 'customer invoices';",
+		},
+		{
+			name: "products",
+			callback: func(bp *Blueprint) {
+				bp.DropCheck("products_discount_check")
+				bp.Check("products_stock_check", "stock >= 0")
+			},
+			expected: "alter table `products` drop check `products_discount_check`;\nalter table `products` add constraint `products_stock_check` check (stock >= 0);",
+		},
+		{
+			name: "users",
+			callback: func(bp *Blueprint) {
+				bp.IndexColumn("email").WithLength("email", 191).WithComment("login lookup")
+				bp.IndexColumns("created_at", "id").Desc("created_at").Invisible()
+			},
+			expected: "alter table `users` add index `users_email_index`(`email`(191)) comment 'login lookup';\nalter table `users` add index `users_created_at_id_index`(`created_at` desc, `id`) invisible;",
+		},
+		{
+			name: "accounts",
+			callback: func(bp *Blueprint) {
+				bp.IndexExpression("accounts_lower_email_index", "lower(email)")
+				bp.IndexColumn("tenant_id").Expr("cast(created_at as date)").Named("accounts_tenant_day_index")
+			},
+			expected: "alter table `accounts` add index `accounts_lower_email_index`((lower(email)));\nalter table `accounts` add index `accounts_tenant_day_index`(`tenant_id`, (cast(created_at as date)));",
+		},
+		{
+			name: "events",
+			callback: func(bp *Blueprint) {
+				bp.DropPartition("p2025_12")
+				bp.ReorganizePartition([]string{"p_future"},
+					RangePartition("p2026_02", "to_days('2026-03-01')"),
+					RangePartition("p_future", "maxvalue"),
+				)
+			},
+			expected: "alter table `events` drop partition `p2025_12`;\nalter table `events` reorganize partition `p_future` into (partition `p2026_02` values less than (to_days('2026-03-01')), partition `p_future` values less than (maxvalue));",
+		},
+		{
+			name: "cache",
+			callback: func(bp *Blueprint) {
+				bp.AddPartition(ListPartition("p_asia", "4"))
+				bp.PartitionByKey(4)
+			},
+			expected: "alter table `cache` add partition (partition `p_asia` values in (4));\nalter table `cache` partition by key () partitions 4;",
+		},
+		{
+			name: "orders",
+			callback: func(bp *Blueprint) {
+				previous := NewColumn("status", ColumnTypeEnum, 0).Enum("pending", "paid").Default("pending").AddComment("order status")
+				bp.AddEnumValues(previous, "refunded", "paid")
+			},
+			expected: "alter table `orders` modify column `status` enum('pending','paid','refunded') not null default 'pending' comment 'order status';",
+		},
+		{
+			name: "sessions",
+			callback: func(bp *Blueprint) {
+				bp.StartingValue(500)
+			},
+			expected: "alter table `sessions` auto_increment=500;",
+		},
 	}
 
 	for _, c := range cases {
@@ -133,6 +314,32 @@ func TestSchema_Alter_WithMySQLGrammar(t *testing.T) {
 	}
 }
 
+func TestSchema_AlterEnum_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	var previous *Column
+	schema.Create("orders", func(bp *Blueprint) {
+		previous = bp.Enum("status", []string{"a", "b"}).NotNull().Default("a").AddComment("status")
+	})
+	schema.Alter("orders", func(bp *Blueprint) {
+		col := bp.AlterEnum(previous, "a", "b", "c").Nullable()
+		col.GetDefaultValue().Set("b")
+		col.GetComment().Set("order status")
+		col.GetEnumValues().Values[0] = "z"
+	})
+
+	expectedSQL := "create table `orders`(`status` enum('a','b') not null default 'a' comment 'status') default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `orders` modify column `status` enum('z','b','c') null default 'b' comment 'order status';"
+	generatedSQL, err := schema.Build()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if generatedSQL != expectedSQL {
+		t.Errorf("Expected: %s", expectedSQL)
+		t.Errorf("Got: %s", generatedSQL)
+	}
+}
+
 func TestSchema_Drop_WithMySQLGrammar(t *testing.T) {
 	var cases = []struct {
 		name     string
@@ -140,7 +347,7 @@ func TestSchema_Drop_WithMySQLGrammar(t *testing.T) {
 	}{
 		{
 			name:     "users",
-			expected: "drop table if exists `users`;",
+			expected: "drop table `users`;",
 		},
 	}
 
@@ -161,3 +368,769 @@ func TestSchema_Drop_WithMySQLGrammar(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_TableStatements_WithMySQLGrammar(t *testing.T) {
+	var cases = []struct {
+		name     string
+		build    func(*Schema)
+		expected string
+	}{
+		{
+			name: "create if not exists",
+			build: func(s *Schema) {
+				s.CreateIfNotExists("tags", func(bp *Blueprint) {
+					bp.Id()
+				})
+			},
+			expected: "create table if not exists `tags`(`id` bigint unsigned not null auto_increment primary key) default character set utf8mb4 collate 'utf8mb4_unicode_ci';",
+		},
+		{
+			name: "drop if exists",
+			build: func(s *Schema) {
+				s.DropIfExists("users")
+			},
+			expected: "drop table if exists `users`;",
+		},
+		{
+			name: "rename",
+			build: func(s *Schema) {
+				s.Rename("users", "members")
+			},
+			expected: "rename table `users` to `members`;",
+		},
+		{
+			name: "truncate",
+			build: func(s *Schema) {
+				s.Truncate("sessions")
+			},
+			expected: "truncate table `sessions`;",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(MySQL)
+			c.build(schema)
+			generatedSQL, err := schema.Build()
+
+			if err != nil {
+				t.Errorf("Error: %s", err)
+			}
+
+			if generatedSQL != c.expected {
+				t.Errorf("Expected: %s", c.expected)
+				t.Errorf("Got: %s", generatedSQL)
+			}
+		})
+	}
+}
+
+func TestSchema_Database_WithMySQLGrammar(t *testing.T) {
+	var cases = []struct {
+		name     string
+		build    func(*Schema)
+		expected string
+	}{
+		{
+			name: "create",
+			build: func(s *Schema) {
+				s.CreateDatabase("app_test", DatabaseOptions{})
+			},
+			expected: "create database `app_test` default character set utf8mb4 collate 'utf8mb4_unicode_ci';",
+		},
+		{
+			name: "create if not exists with options",
+			build: func(s *Schema) {
+				s.CreateDatabaseIfNotExists("app_test", DatabaseOptions{CharSet: "latin1", Collation: "latin1_swedish_ci"})
+			},
+			expected: "create database if not exists `app_test` default character set latin1 collate 'latin1_swedish_ci';",
+		},
+		{
+			name: "drop",
+			build: func(s *Schema) {
+				s.DropDatabase("app_test").DropDatabaseIfExists("app_test_2")
+			},
+			expected: "drop database `app_test`;\ndrop database if exists `app_test_2`;",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(MySQL)
+			c.build(schema)
+			generatedSQL, err := schema.Build()
+
+			if err != nil {
+				t.Errorf("Error: %s", err)
+			}
+
+			if generatedSQL != c.expected {
+				t.Errorf("Expected: %s", c.expected)
+				t.Errorf("Got: %s", generatedSQL)
+			}
+		})
+	}
+}
+
+func TestSchema_PartialIndex_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	schema.Alter("users", func(bp *Blueprint) {
+		bp.IndexColumn("email").Where("deleted_at is null")
+	})
+	_, err := schema.Build()
+
+	if !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected: %s", ErrNotSupported)
+		t.Errorf("Got: %v", err)
+	}
+}
+
+func TestSchema_FullTextLanguage_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	schema.Alter("articles", func(bp *Blueprint) {
+		bp.FullText("title", "body").WithLanguage("english")
+	})
+	_, err := schema.Build()
+
+	if !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected: %s", ErrNotSupported)
+		t.Errorf("Got: %v", err)
+	}
+}
+
+func TestSchema_ForeignKeyIndexes_WithMySQLGrammar(t *testing.T) {
+	var cases = []struct {
+		name     string
+		index    bool
+		callback func(*Blueprint)
+		expected string
+	}{
+		{
+			name: "constrained",
+			callback: func(bp *Blueprint) {
+				_, userId := bp.ForeignId("user_id")
+				userId.Constrained()
+				bp.BigInt("team_id").Unsigned().Constrained()
+			},
+			expected: "create table `posts`(`user_id` bigint unsigned,`team_id` bigint unsigned) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `posts` add constraint `posts_user_id_foreign` foreign key (`user_id`) references `users` (`id`);\nalter table `posts` add index `posts_user_id_index`(`user_id`);\nalter table `posts` add constraint `posts_team_id_foreign` foreign key (`team_id`) references `teams` (`id`);\nalter table `posts` add index `posts_team_id_index`(`team_id`);",
+		},
+		{
+			name:  "schema option",
+			index: true,
+			callback: func(bp *Blueprint) {
+				bp.ForeignId("user_id")
+				bp.ForeignId("team_id")
+				bp.IndexColumns("team_id", "user_id")
+			},
+			expected: "create table `posts`(`user_id` bigint unsigned,`team_id` bigint unsigned) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `posts` add constraint `posts_user_id_foreign` foreign key (`user_id`) references `users` (`id`);\nalter table `posts` add constraint `posts_team_id_foreign` foreign key (`team_id`) references `teams` (`id`);\nalter table `posts` add index `posts_team_id_user_id_index`(`team_id`, `user_id`);\nalter table `posts` add index `posts_user_id_index`(`user_id`);",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(MySQL).IndexForeignKeys(c.index)
+			schema.Create("posts", c.callback)
+			generatedSQL, err := schema.Build()
+
+			if err != nil {
+				t.Errorf("Error: %s", err)
+			}
+
+			if generatedSQL != c.expected {
+				t.Errorf("Expected: %s", c.expected)
+				t.Errorf("Got: %s", generatedSQL)
+			}
+		})
+	}
+}
+
+func TestSchema_Views_WithMySQLGrammar(t *testing.T) {
+	var cases = []struct {
+		name     string
+		build    func(*Schema)
+		expected string
+	}{
+		{
+			name: "create with options",
+			build: func(s *Schema) {
+				s.CreateView("active_users", "select * from users where deleted_at is null", ViewOptions{Algorithm: ViewAlgorithmMerge, Security: ViewSecurityInvoker})
+			},
+			expected: "create algorithm=merge sql security invoker view `active_users` as select * from users where deleted_at is null;",
+		},
+		{
+			name: "create or replace and drop",
+			build: func(s *Schema) {
+				s.CreateOrReplaceView("totals", "select sum(amount) from `orders`", ViewOptions{}).DropView("legacy_totals")
+			},
+			expected: "create or replace view `totals` as select sum(amount) from `orders`;\ndrop view `legacy_totals`;",
+		},
+		{
+			name: "ordered after referenced tables",
+			build: func(s *Schema) {
+				s.CreateView("report", "select * from monthly_totals join accounts on accounts.id = monthly_totals.account_id", ViewOptions{})
+				s.CreateView("monthly_totals", "select account_id, sum(amount) from payments group by account_id", ViewOptions{})
+				s.Create("payments", func(bp *Blueprint) {
+					bp.Id()
+				})
+			},
+			expected: "create table `payments`(`id` bigint unsigned not null auto_increment primary key) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\ncreate view `monthly_totals` as select account_id, sum(amount) from payments group by account_id;\ncreate view `report` as select * from monthly_totals join accounts on accounts.id = monthly_totals.account_id;",
+		},
+		{
+			name: "tables keep their order",
+			build: func(s *Schema) {
+				s.CreateView("book_list", "select title from books", ViewOptions{})
+				s.Create("authors", func(bp *Blueprint) {
+					bp.Id()
+				})
+				s.Create("books", func(bp *Blueprint) {
+					bp.Id()
+					bp.String("title", 255)
+					bp.ForeignId("author_id")
+				})
+			},
+			expected: "create table `authors`(`id` bigint unsigned not null auto_increment primary key) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\ncreate table `books`(`id` bigint unsigned not null auto_increment primary key,`title` varchar(255),`author_id` bigint unsigned) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `books` add constraint `books_author_id_foreign` foreign key (`author_id`) references `authors` (`id`);\ncreate view `book_list` as select title from books;",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(MySQL)
+			c.build(schema)
+			generatedSQL, err := schema.Build()
+
+			if err != nil {
+				t.Errorf("Error: %s", err)
+			}
+
+			if generatedSQL != c.expected {
+				t.Errorf("Expected: %s", c.expected)
+				t.Errorf("Got: %s", generatedSQL)
+			}
+		})
+	}
+
+	schema := NewSchema(MySQL).CreateMaterializedView("report", "select 1")
+	if _, err := schema.Build(); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected: %s", ErrNotSupported)
+		t.Errorf("Got: %v", err)
+	}
+}
+
+func TestSchema_Triggers_WithMySQLGrammar(t *testing.T) {
+	build := func() *Schema {
+		schema := NewSchema(MySQL)
+		schema.Create("audits", func(bp *Blueprint) {
+			bp.Id()
+			bp.String("user_id", 36).Unique()
+		})
+		schema.CreateTrigger("users_audit", "users", TriggerAfter, TriggerUpdate, "insert into audits (user_id) values (new.id);\nset @audited = 1;")
+		schema.DropTrigger("users_legacy_audit", "users")
+		return schema
+	}
+
+	expectedSQL := "create table `audits`(`id` bigint unsigned not null auto_increment primary key,`user_id` varchar(36)) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `audits` add unique `audits_user_id_unique`(`user_id`);\ndelimiter $$\ncreate trigger `users_audit` after update on `users` for each row begin\ninsert into audits (user_id) values (new.id);\nset @audited = 1;\nend$$\ndelimiter ;\ndrop trigger `users_legacy_audit`;"
+	generatedSQL, err := build().Build()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if generatedSQL != expectedSQL {
+		t.Errorf("Expected: %s", expectedSQL)
+		t.Errorf("Got: %s", generatedSQL)
+	}
+
+	expectedStatements := []string{
+		"create table `audits`(`id` bigint unsigned not null auto_increment primary key,`user_id` varchar(36)) default character set utf8mb4 collate 'utf8mb4_unicode_ci';",
+		"alter table `audits` add unique `audits_user_id_unique`(`user_id`);",
+		"create trigger `users_audit` after update on `users` for each row begin\ninsert into audits (user_id) values (new.id);\nset @audited = 1;\nend;",
+		"drop trigger `users_legacy_audit`;",
+	}
+	statements, err := build().Statements()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if len(statements) != len(expectedStatements) {
+		t.Fatalf("Expected %d statements, got %d: %q", len(expectedStatements), len(statements), statements)
+	}
+
+	for i := range statements {
+		if statements[i] != expectedStatements[i] {
+			t.Errorf("Expected: %s", expectedStatements[i])
+			t.Errorf("Got: %s", statements[i])
+		}
+	}
+}
+
+func TestSchema_Statements_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	schema.Alter("users", func(bp *Blueprint) {
+		bp.String("note", 255).AddComment("first;\nsecond")
+		bp.IndexColumn("note")
+	})
+	schema.CreateView("notes", "select 'first;\nsecond' as note", ViewOptions{})
+	schema.CreateTrigger("users_touch", "users", TriggerBefore, TriggerUpdate, "\n  touch: begin\nset new.note = 'x;\ny';\nend touch")
+
+	expectedStatements := []string{
+		"alter table `users` add `note` varchar(255) comment 'first;\nsecond';",
+		"alter table `users` add index `users_note_index`(`note`);",
+		"create view `notes` as select 'first;\nsecond' as note;",
+		"create trigger `users_touch` before update on `users` for each row touch: begin\nset new.note = 'x;\ny';\nend touch;",
+	}
+	statements, err := schema.Statements()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if len(statements) != len(expectedStatements) {
+		t.Fatalf("Expected %d statements, got %d: %q", len(expectedStatements), len(statements), statements)
+	}
+
+	for i := range statements {
+		if statements[i] != expectedStatements[i] {
+			t.Errorf("Expected: %s", expectedStatements[i])
+			t.Errorf("Got: %s", statements[i])
+		}
+	}
+}
+
+func TestSchema_Routines_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	schema.CreateProcedure("archive_orders", []RoutineParameter{Param("before", "date"), OutParam("moved", "int")}, "insert into orders_archive select * from orders where created_at < before;\ndelete from orders where created_at < before;\nset moved = row_count();", RoutineOptions{Security: RoutineSecurityInvoker, DataAccess: DataAccessModifiesSQL})
+	schema.CreateFunction("net_price", []RoutineParameter{Param("gross", "decimal(10,2)")}, "decimal(10,2)", "return gross / 1.2", RoutineOptions{Deterministic: true, DataAccess: DataAccessNoSQL})
+	schema.DropProcedure("legacy_archive").DropFunction("legacy_price")
+
+	expectedStatements := []string{
+		"create procedure `archive_orders`(in `before` date, out `moved` int) modifies sql data sql security invoker begin\ninsert into orders_archive select * from orders where created_at < before;\ndelete from orders where created_at < before;\nset moved = row_count();\nend;",
+		"create function `net_price`(`gross` decimal(10,2)) returns decimal(10,2) deterministic no sql begin\nreturn gross / 1.2;\nend;",
+		"drop procedure `legacy_archive`;",
+		"drop function `legacy_price`;",
+	}
+	statements, err := schema.Statements()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if len(statements) != len(expectedStatements) {
+		t.Fatalf("Expected %d statements, got %d: %q", len(expectedStatements), len(statements), statements)
+	}
+
+	for i := range statements {
+		if statements[i] != expectedStatements[i] {
+			t.Errorf("Expected: %s", expectedStatements[i])
+			t.Errorf("Got: %s", statements[i])
+		}
+	}
+
+	schema.CreateFunction("broken", []RoutineParameter{OutParam("result", "int")}, "int", "return 1", RoutineOptions{})
+	if _, err := schema.Build(); err == nil {
+		t.Errorf("Expected an error for an output parameter on a function")
+	}
+}
+
+func TestSchema_Namespaces_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	schema.CreateNamespaceIfNotExists("billing")
+	schema.Create("billing.invoices", func(bp *Blueprint) {
+		bp.Id()
+		fk, _ := bp.ForeignId("customer_id")
+		fk.On("crm.customers", "id")
+		bp.IndexColumn("customer_id")
+	})
+	schema.Rename("billing.invoices", "billing.invoices_old")
+
+	expectedSQL := "create schema if not exists `billing`;\ncreate table `billing`.`invoices`(`id` bigint unsigned not null auto_increment primary key,`customer_id` bigint unsigned) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `billing`.`invoices` add constraint `invoices_customer_id_foreign` foreign key (`customer_id`) references `crm`.`customers` (`id`);\nalter table `billing`.`invoices` add index `invoices_customer_id_index`(`customer_id`);\nrename table `billing`.`invoices` to `billing`.`invoices_old`;"
+	generatedSQL, err := schema.Build()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if generatedSQL != expectedSQL {
+		t.Errorf("Expected: %s", expectedSQL)
+		t.Errorf("Got: %s", generatedSQL)
+	}
+
+	schema.CreateSequence("invoice_numbers", SequenceOptions{Start: 1000})
+	if _, err := schema.Build(); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected: %s", ErrNotSupported)
+		t.Errorf("Got: %v", err)
+	}
+}
+
+func TestSchema_Identity_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	schema.Create("invoices", func(bp *Blueprint) {
+		bp.BigInt("number").GeneratedByDefaultAsIdentity(SequenceOptions{}).Primary()
+	})
+
+	expectedSQL := "create table `invoices`(`number` bigint auto_increment primary key) default character set utf8mb4 collate 'utf8mb4_unicode_ci';"
+	generatedSQL, err := schema.Build()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if generatedSQL != expectedSQL {
+		t.Errorf("Expected: %s", expectedSQL)
+		t.Errorf("Got: %s", generatedSQL)
+	}
+
+	for name, column := range map[string]func(bp *Blueprint){
+		"always":  func(bp *Blueprint) { bp.BigInt("number").GeneratedAlwaysAsIdentity(SequenceOptions{}) },
+		"options": func(bp *Blueprint) { bp.BigInt("number").GeneratedByDefaultAsIdentity(SequenceOptions{Start: 1000}) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			schema := NewSchema(MySQL)
+			schema.Create("invoices", column)
+			if _, err := schema.Build(); !errors.Is(err, ErrNotSupported) {
+				t.Errorf("Expected: %s", ErrNotSupported)
+				t.Errorf("Got: %v", err)
+			}
+		})
+	}
+}
+
+// enumTypeGrammar compiles enum types the way Postgres does, as MySQL has none. Tables are compiled as their
+// mode and name only, as MySQL cannot compile their columns of enum types either.
+type enumTypeGrammar struct {
+	MySqlGrammar
+}
+
+func (g *enumTypeGrammar) Build(b *Blueprint) (string, error) {
+	return fmt.Sprintf("%s table %s;", b.Mode(), b.GetTable()), nil
+}
+
+func (g *enumTypeGrammar) CompileCreateEnumType(e *EnumType) (string, error) {
+	return fmt.Sprintf("create type %s as enum ('%s');", e.GetName(), strings.Join(e.GetValues(), "', '")), nil
+}
+
+func (g *enumTypeGrammar) CompileAddEnumTypeValue(e *EnumType, value string) (string, error) {
+	return fmt.Sprintf("alter type %s add value '%s';", e.GetName(), value), nil
+}
+
+func (g *enumTypeGrammar) CompileDropEnumType(e *EnumType) (string, error) {
+	if e.Mode() == "dropIfExists" {
+		return fmt.Sprintf("drop type if exists %s;", e.GetName()), nil
+	}
+	return fmt.Sprintf("drop type %s;", e.GetName()), nil
+}
+
+func TestSchema_EnumTypes(t *testing.T) {
+	status := NewColumn("status", ColumnTypeEnum, 0).EnumType("order_status")
+
+	cases := []struct {
+		name     string
+		build    func(s *Schema)
+		expected []string
+	}{
+		{
+			name: "statements",
+			build: func(s *Schema) {
+				s.CreateEnumType("order_status", "pending", "paid")
+				s.AddEnumTypeValues("order_status", "refunded", "cancelled")
+				s.DropEnumType("order_status")
+			},
+			expected: []string{
+				"create type order_status as enum ('pending', 'paid');",
+				"alter type order_status add value 'refunded';",
+				"alter type order_status add value 'cancelled';",
+				"drop type order_status;",
+			},
+		},
+		{
+			name: "created before the tables using it",
+			build: func(s *Schema) {
+				s.Create("customers", func(bp *Blueprint) {
+					bp.Id()
+				})
+				s.Create("orders", func(bp *Blueprint) {
+					bp.Id()
+					bp.EnumType("status", "order_status").NotNull()
+				})
+				s.CreateEnumType("order_status", "pending", "paid")
+			},
+			expected: []string{
+				"create table customers;",
+				"create type order_status as enum ('pending', 'paid');",
+				"create table orders;",
+			},
+		},
+		{
+			name: "values added when altering a column",
+			build: func(s *Schema) {
+				s.Alter("orders", func(bp *Blueprint) {
+					bp.AddEnumValues(status, "refunded")
+					bp.String("note", 255)
+				})
+				s.Alter("orders", func(bp *Blueprint) {
+					bp.AddEnumValues(status, "cancelled")
+				})
+			},
+			expected: []string{
+				"alter type order_status add value 'refunded';",
+				"alter table orders;",
+				"alter type order_status add value 'cancelled';",
+			},
+		},
+		{
+			name: "dropped with the last table",
+			build: func(s *Schema) {
+				s.CreateEnumType("order_status", "pending", "paid")
+				s.Create("orders", func(bp *Blueprint) {
+					bp.Id()
+					bp.EnumType("status", "order_status").NotNull()
+				})
+				s.Create("returns", func(bp *Blueprint) {
+					bp.Id()
+					bp.EnumType("order_status", "order_status")
+				})
+				s.Rename("returns", "refunds")
+				s.Drop("orders")
+				s.DropIfExists("refunds")
+			},
+			expected: []string{
+				"create type order_status as enum ('pending', 'paid');",
+				"create table orders;",
+				"create table returns;",
+				"rename table returns;",
+				"drop table orders;",
+				"dropIfExists table refunds;",
+				"drop type if exists order_status;",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(&enumTypeGrammar{})
+			c.build(schema)
+			statements, err := schema.Statements()
+
+			if err != nil {
+				t.Errorf("Error: %s", err)
+			}
+
+			if !reflect.DeepEqual(statements, c.expected) {
+				t.Errorf("Expected: %q", c.expected)
+				t.Errorf("Got: %q", statements)
+			}
+		})
+	}
+
+	schema := NewSchema(MySQL)
+	schema.Alter("orders", func(bp *Blueprint) {
+		bp.AlterEnum(status, "pending")
+	})
+	if _, err := schema.Build(); err == nil || !strings.Contains(err.Error(), "can only be added to") {
+		t.Errorf("Expected an error for changing the values of an enum type, got: %v", err)
+	}
+
+	schema = NewSchema(MySQL)
+	schema.CreateEnumType("order_status", "pending", "paid")
+	if _, err := schema.Build(); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected: %s", ErrNotSupported)
+		t.Errorf("Got: %v", err)
+	}
+}
+
+type OrderItem struct{}
+
+func TestSchema_Relationships_WithMySQLGrammar(t *testing.T) {
+	var cases = []struct {
+		name     string
+		build    func(*Schema)
+		expected string
+	}{
+		{
+			name: "morphs",
+			build: func(s *Schema) {
+				s.Create("comments", func(bp *Blueprint) {
+					bp.Morphs("commentable")
+					bp.NullableMorphs("author")
+					bp.UuidMorphs("subject")
+				})
+			},
+			expected: "create table `comments`(`commentable_type` varchar(255) not null,`commentable_id` bigint unsigned not null,`author_type` varchar(255) null,`author_id` bigint unsigned null,`subject_type` varchar(255) not null,`subject_id` char(36) not null) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `comments` add index `comments_commentable_type_commentable_id_index`(`commentable_type`, `commentable_id`);\nalter table `comments` add index `comments_author_type_author_id_index`(`author_type`, `author_id`);\nalter table `comments` add index `comments_subject_type_subject_id_index`(`subject_type`, `subject_id`);",
+		},
+		{
+			name: "pivot",
+			build: func(s *Schema) {
+				s.Pivot("users", "roles", func(bp *Blueprint) {
+					bp.Timestamps()
+				})
+			},
+			expected: "create table `role_user`(`role_id` bigint unsigned not null,`user_id` bigint unsigned not null,`created_at` timestamp not null default CURRENT_TIMESTAMP,`updated_at` timestamp not null default CURRENT_TIMESTAMP on update CURRENT_TIMESTAMP) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `role_user` add constraint `role_user_role_id_foreign` foreign key (`role_id`) references `roles` (`id`) on delete cascade;\nalter table `role_user` add constraint `role_user_user_id_foreign` foreign key (`user_id`) references `users` (`id`) on delete cascade;\nalter table `role_user` add primary key (`role_id`, `user_id`);",
+		},
+		{
+			name: "foreign id for",
+			build: func(s *Schema) {
+				s.Create("shipments", func(bp *Blueprint) {
+					bp.ForeignIdFor(&OrderItem{})
+				})
+			},
+			expected: "create table `shipments`(`order_item_id` bigint unsigned) default character set utf8mb4 collate 'utf8mb4_unicode_ci';\nalter table `shipments` add constraint `shipments_order_item_id_foreign` foreign key (`order_item_id`) references `order_items` (`id`);",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(MySQL)
+			c.build(schema)
+			generatedSQL, err := schema.Build()
+
+			if err != nil {
+				t.Errorf("Error: %s", err)
+			}
+
+			if generatedSQL != c.expected {
+				t.Errorf("Expected: %s", c.expected)
+				t.Errorf("Got: %s", generatedSQL)
+			}
+		})
+	}
+}
+
+func TestSchema_RelationshipErrors_WithMySQLGrammar(t *testing.T) {
+	cases := map[string]func(s *Schema){
+		"pivot with itself": func(s *Schema) {
+			s.Pivot("users", "users")
+		},
+		"foreign id for nil": func(s *Schema) {
+			s.Create("shipments", func(bp *Blueprint) {
+				bp.ForeignIdFor(nil)
+			})
+		},
+		"foreign id for an anonymous struct": func(s *Schema) {
+			s.Create("shipments", func(bp *Blueprint) {
+				fk, col := bp.ForeignIdFor(struct{ ID uint64 }{})
+				fk.CascadeOnDelete()
+				col.NotNull()
+			})
+		},
+	}
+
+	for name, build := range cases {
+		t.Run(name, func(t *testing.T) {
+			schema := NewSchema(MySQL)
+			build(schema)
+			if generatedSQL, err := schema.Build(); err == nil {
+				t.Errorf("Expected an error, got: %s", generatedSQL)
+			}
+		})
+	}
+}
+
+type timestamps struct {
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+type account struct {
+	ID       uint64
+	Email    string         `db:"email" blackhole:"size:191;unique"`
+	Name     sql.NullString `db:"display_name"`
+	Bio      *string        `blackhole:"type:text"`
+	Balance  float64        `blackhole:"default:0"`
+	Age      int32
+	Settings json.RawMessage
+	Secret   string `db:"-"`
+	internal string
+	timestamps
+}
+
+type Deletion struct {
+	DeletedAt time.Time
+	DeletedBy string
+}
+
+type post struct {
+	ID uint64
+	*timestamps
+	*Deletion
+}
+
+func TestSchema_CreateFromStruct_WithMySQLGrammar(t *testing.T) {
+	schema := NewSchema(MySQL)
+	schema.CreateFromStruct("accounts", &account{})
+	generatedSQL, err := schema.Build()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	schema.Create("accounts", func(bp *Blueprint) {
+		bp.Id()
+		bp.String("email", 191).NotNull().Unique()
+		bp.String("display_name", 255).Nullable()
+		bp.Text("bio").Nullable()
+		bp.Double("balance").Default("0")
+		bp.Int("age").NotNull()
+		bp.Json("settings").NotNull()
+		bp.DateTime("created_at").NotNull()
+		bp.DateTime("updated_at").Nullable()
+	})
+	expectedSQL, err := schema.Build()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	if generatedSQL != expectedSQL {
+		t.Errorf("Expected: %s", expectedSQL)
+		t.Errorf("Got: %s", generatedSQL)
+	}
+
+	schema.CreateFromStruct("posts", &post{})
+	generatedSQL, err = schema.Build()
+
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	}
+
+	schema.Create("posts", func(bp *Blueprint) {
+		bp.Id()
+		bp.DateTime("created_at").Nullable()
+		bp.DateTime("updated_at").Nullable()
+		bp.DateTime("deleted_at").Nullable()
+		bp.String("deleted_by", 255).Nullable()
+	})
+	expectedSQL, _ = schema.Build()
+
+	if generatedSQL != expectedSQL {
+		t.Errorf("Expected: %s", expectedSQL)
+		t.Errorf("Got: %s", generatedSQL)
+	}
+
+	schema.CreateFromStruct("broken", struct{ Tags []string }{})
+	if _, err := schema.Build(); err == nil || !strings.Contains(err.Error(), `field "Tags": unsupported type []string`) {
+		t.Errorf("Expected an unsupported type error, got: %v", err)
+	}
+	schema.CreateFromStruct("broken", struct {
+		Bio string `blackhole:"type:txt"`
+	}{})
+	if _, err := schema.Build(); err == nil || !strings.Contains(err.Error(), `invalid type "txt"`) {
+		t.Errorf("Expected an invalid type error, got: %v", err)
+	}
+}
+
+func TestForeignKey_References(t *testing.T) {
+	bp := NewBlueprint("posts")
+	fk, _ := bp.ForeignId("user_id")
+
+	if table, column := fk.References(); table != "users" || column != "id" {
+		t.Errorf("Expected users.id, got %s.%s", table, column)
+	}
+	if fk.ReferencedTable() != "" || fk.ReferencedColumn() != "" {
+		t.Errorf("Expected the references to be discovered when built, got %s.%s", fk.ReferencedTable(), fk.ReferencedColumn())
+	}
+
+	fk.On("authors", "uuid")
+	if table, column := fk.References(); table != "authors" || column != "uuid" {
+		t.Errorf("Expected authors.uuid, got %s.%s", table, column)
+	}
+}
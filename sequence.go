@@ -0,0 +1,69 @@
+package blackhole
+
+import "fmt"
+
+// SequenceOptions holds the options a sequence is created or altered with. Zero values are left to the database.
+type SequenceOptions struct {
+	Start     int64
+	Increment int64
+	MinValue  int64
+	MaxValue  int64
+	Cache     int64
+	Cycle     bool
+	OwnedBy   string
+}
+
+// Sequence represents a standalone sequence generator.
+type Sequence struct {
+	mode    string
+	name    string
+	options SequenceOptions
+	grammar *Grammar
+}
+
+// NewSequence creates a new Sequence instance with the specified name and options.
+func NewSequence(name string, options SequenceOptions) *Sequence {
+	return &Sequence{
+		name:    name,
+		options: options,
+	}
+}
+
+// Grammar sets the grammar for the sequence.
+func (s *Sequence) Grammar(grammar *Grammar) {
+	s.grammar = grammar
+}
+
+// GetName returns the name of the sequence.
+func (s *Sequence) GetName() string {
+	return s.name
+}
+
+// GetOptions returns the options of the sequence.
+func (s *Sequence) GetOptions() SequenceOptions {
+	return s.options
+}
+
+// Mode returns the mode (create, alter, drop) of the sequence.
+func (s *Sequence) Mode() string {
+	return s.mode
+}
+
+// setMode sets the mode of the sequence.
+func (s *Sequence) setMode(mode string) *Sequence {
+	s.mode = mode
+	return s
+}
+
+// Build builds the SQL statement for the sequence using the associated grammar.
+func (s *Sequence) Build() (string, error) {
+	switch s.mode {
+	case "create":
+		return (*s.grammar).CompileCreateSequence(s)
+	case "alter":
+		return (*s.grammar).CompileAlterSequence(s)
+	case "drop":
+		return (*s.grammar).CompileDropSequence(s)
+	}
+	return "", fmt.Errorf("blackhole: invalid sequence mode given : %s", s.mode)
+}
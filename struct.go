@@ -0,0 +1,234 @@
+package blackhole
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	bytesType      = reflect.TypeOf([]byte{})
+)
+
+// nullTypes maps the database/sql null wrappers to the column type of the value they wrap.
+var nullTypes = map[reflect.Type]func(name string) *Column{
+	reflect.TypeOf(sql.NullString{}):  func(name string) *Column { return stringColumn(name, 255) },
+	reflect.TypeOf(sql.NullInt64{}):   bigIntColumn,
+	reflect.TypeOf(sql.NullInt32{}):   integerColumn,
+	reflect.TypeOf(sql.NullInt16{}):   smIntColumn,
+	reflect.TypeOf(sql.NullByte{}):    func(name string) *Column { return tinyIntColumn(name).Unsigned() },
+	reflect.TypeOf(sql.NullBool{}):    boolColumn,
+	reflect.TypeOf(sql.NullFloat64{}): doubleColumn,
+	reflect.TypeOf(sql.NullTime{}):    dateTimeColumn,
+}
+
+// FromStruct adds a column to the blueprint for every exported field of the struct v, flattening embedded structs.
+// The columns of structs embedded through a pointer are nullable, as the pointer may be nil.
+//
+// Column names come from the `db` tag, falling back to the snake cased field name, and fields tagged `db:"-"` are
+// skipped. Go types are mapped to column types (int64 to bigint, string to varchar(255), time.Time to datetime,
+// json.RawMessage to json, and so on), pointers and sql.Null* types become nullable columns and every other column
+// is not null. An integer field named "id" without a `blackhole` tag becomes the Id() column.
+//
+// Modifiers are read from the `blackhole` tag as semicolon separated options:
+// size:191, type:text, precision:10, scale:2, default:value, comment:text, primary, autoIncrement, unsigned,
+// nullable, unique and index.
+func (b *Blueprint) FromStruct(v any) error {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("blackhole: FromStruct: expected a struct, got %T", v)
+	}
+	return b.addStructFields(t, false)
+}
+
+// addStructFields adds the columns of the fields of the struct type t to the blueprint, making all of them
+// nullable if the struct is embedded through a pointer.
+func (b *Blueprint) addStructFields(t reflect.Type, nullable bool) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := field.Tag.Lookup("db")
+		if name == "-" {
+			continue
+		}
+		// Embedded structs promote their exported fields, even when the embedded type itself is unexported.
+		if embedded, pointer := embeddedStruct(field); embedded != nil && !ok {
+			if err := b.addStructFields(embedded, nullable || pointer); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = snakeCase(field.Name)
+		}
+
+		tag, hasTag := field.Tag.Lookup("blackhole")
+		if name == "id" && !hasTag && !nullable && isIntegerKind(field.Type.Kind()) {
+			b.Id()
+			continue
+		}
+
+		col, err := columnForType(name, field.Type)
+		if err != nil {
+			return fmt.Errorf("blackhole: FromStruct: field %q: %w", field.Name, err)
+		}
+		b.addColumn(col)
+
+		if err := applyStructTag(col, tag); err != nil {
+			return fmt.Errorf("blackhole: FromStruct: field %q: %w", field.Name, err)
+		}
+		if nullable {
+			col.Nullable()
+		}
+	}
+	return nil
+}
+
+// embeddedStruct returns the struct type of an embedded struct field, and whether it is embedded through a pointer.
+// It returns nil for other fields, and for embedded types stored in a single column such as time.Time.
+func embeddedStruct(field reflect.StructField) (reflect.Type, bool) {
+	if !field.Anonymous {
+		return nil, false
+	}
+	t, pointer := field.Type, false
+	if t.Kind() == reflect.Pointer {
+		t, pointer = t.Elem(), true
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return nil, false
+	}
+	if _, ok := nullTypes[t]; ok {
+		return nil, false
+	}
+	return t, pointer
+}
+
+// columnForType returns the column a Go type is stored in.
+func columnForType(name string, t reflect.Type) (*Column, error) {
+	if newColumn, ok := nullTypes[t]; ok {
+		return newColumn(name).Nullable(), nil
+	}
+	if t.Kind() == reflect.Pointer {
+		col, err := columnForType(name, t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return col.Nullable(), nil
+	}
+
+	switch t {
+	case timeType:
+		return dateTimeColumn(name).NotNull(), nil
+	case rawMessageType:
+		return jsonColumn(name).NotNull(), nil
+	case bytesType:
+		return NewColumn(name, ColumnTypeVarBinary, 255).NotNull(), nil
+	}
+
+	var col *Column
+	switch t.Kind() {
+	case reflect.Bool:
+		col = boolColumn(name)
+	case reflect.Int8:
+		col = tinyIntColumn(name)
+	case reflect.Uint8:
+		col = tinyIntColumn(name).Unsigned()
+	case reflect.Int16:
+		col = smIntColumn(name)
+	case reflect.Uint16:
+		col = smIntColumn(name).Unsigned()
+	case reflect.Int32:
+		col = integerColumn(name)
+	case reflect.Uint32:
+		col = integerColumn(name).Unsigned()
+	case reflect.Int, reflect.Int64:
+		col = bigIntColumn(name)
+	case reflect.Uint, reflect.Uint64:
+		col = bigIntColumn(name).Unsigned()
+	case reflect.Float32:
+		col = floatColumn(name)
+	case reflect.Float64:
+		col = doubleColumn(name)
+	case reflect.String:
+		col = stringColumn(name, 255)
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+	return col.NotNull(), nil
+}
+
+// applyStructTag applies the modifiers of a `blackhole` struct tag to the column.
+func applyStructTag(col *Column, tag string) error {
+	for _, option := range strings.Split(tag, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(option), ":")
+		switch key {
+		case "":
+		case "size":
+			size, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid size %q", value)
+			}
+			col.Length(size)
+		case "type":
+			if !ColumnType(value).IsValid() {
+				return fmt.Errorf("invalid type %q", value)
+			}
+			col.dataType = value
+			if value != string(ColumnTypeChar) && value != string(ColumnTypeVarchar) {
+				col.Length(0)
+			}
+		case "precision":
+			precision, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid precision %q", value)
+			}
+			col.Precision(precision)
+		case "scale":
+			scale, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid scale %q", value)
+			}
+			col.Scale(scale)
+		case "default":
+			col.Default(value)
+		case "comment":
+			col.AddComment(value)
+		case "primary":
+			col.Primary()
+		case "autoIncrement":
+			col.AutoIncrement()
+		case "unsigned":
+			col.Unsigned()
+		case "nullable":
+			col.Nullable()
+		case "unique":
+			col.Unique()
+		case "index":
+			col.Index()
+		default:
+			return fmt.Errorf("unknown tag option %q", key)
+		}
+	}
+	return nil
+}
+
+// isIntegerKind returns whether the kind is a signed or unsigned integer kind.
+func isIntegerKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
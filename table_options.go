@@ -0,0 +1,15 @@
+package blackhole
+
+// TableOptions holds the table level options of a blueprint, such as the storage engine or the table comment.
+type TableOptions struct {
+	Engine        string
+	Comment       *Comment
+	RowFormat     string
+	StartingValue int
+	KeyBlockSize  int
+}
+
+// IsEmpty returns whether none of the table options are set.
+func (t TableOptions) IsEmpty() bool {
+	return t == TableOptions{}
+}
@@ -0,0 +1,97 @@
+package blackhole
+
+import "fmt"
+
+type TriggerTiming string
+type TriggerEvent string
+
+const (
+	TriggerBefore    TriggerTiming = "before"
+	TriggerAfter     TriggerTiming = "after"
+	TriggerInsteadOf TriggerTiming = "instead of"
+)
+
+const (
+	TriggerInsert TriggerEvent = "insert"
+	TriggerUpdate TriggerEvent = "update"
+	TriggerDelete TriggerEvent = "delete"
+)
+
+// Trigger represents a row level trigger on a table. The body may hold several statements.
+type Trigger struct {
+	mode    string
+	name    string
+	table   string
+	timing  TriggerTiming
+	event   TriggerEvent
+	body    string
+	grammar *Grammar
+}
+
+// NewTrigger creates a new Trigger instance firing the body on the table for the given timing and event.
+func NewTrigger(name, table string, timing TriggerTiming, event TriggerEvent, body string) *Trigger {
+	return &Trigger{
+		name:   name,
+		table:  table,
+		timing: timing,
+		event:  event,
+		body:   body,
+	}
+}
+
+// Grammar sets the grammar for the trigger.
+func (t *Trigger) Grammar(grammar *Grammar) {
+	t.grammar = grammar
+}
+
+// GetName returns the name of the trigger.
+func (t *Trigger) GetName() string {
+	return t.name
+}
+
+// GetTable returns the name of the table the trigger is defined on.
+func (t *Trigger) GetTable() string {
+	return t.table
+}
+
+// GetTiming returns when the trigger fires relative to the event.
+func (t *Trigger) GetTiming() TriggerTiming {
+	return t.timing
+}
+
+// GetEvent returns the event the trigger fires on.
+func (t *Trigger) GetEvent() TriggerEvent {
+	return t.event
+}
+
+// GetBody returns the body of the trigger.
+func (t *Trigger) GetBody() string {
+	return t.body
+}
+
+// Mode returns the mode (create, drop) of the trigger.
+func (t *Trigger) Mode() string {
+	return t.mode
+}
+
+// setMode sets the mode of the trigger.
+func (t *Trigger) setMode(mode string) *Trigger {
+	t.mode = mode
+	return t
+}
+
+// isCompound returns whether the statement of the trigger may hold statement terminators of its own.
+func (t *Trigger) isCompound() bool {
+	return t.mode == "create"
+}
+
+// Build builds the SQL statement for the trigger using the associated grammar.
+func (t *Trigger) Build() (string, error) {
+	switch t.mode {
+	case "create":
+		return (*t.grammar).CompileCreateTrigger(t)
+	case "drop":
+		return (*t.grammar).CompileDropTrigger(t)
+	}
+	return "", fmt.Errorf("blackhole: invalid trigger mode given : %s", t.mode)
+}
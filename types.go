@@ -1,5 +1,7 @@
 package blackhole
 
+import "slices"
+
 // ColumnType represents the type of a column in a database table.
 type ColumnType string
 
@@ -29,17 +31,45 @@ const (
 	ColumnTypeDateTime  ColumnType = "datetime"
 	ColumnTypeTime      ColumnType = "time"
 	ColumnTypeTimestamp ColumnType = "timestamp"
+	// Timezone aware variants, mapped to the closest native type by each grammar
+	ColumnTypeTimestampTz ColumnType = "timestamptz"
+	ColumnTypeDateTimeTz  ColumnType = "datetimetz"
+	ColumnTypeYear        ColumnType = "year"
 
 	// Binary column types
 	ColumnTypeBinary    ColumnType = "binary"
 	ColumnTypeVarBinary ColumnType = "varbinary"
 
+	// Spatial column types
+	ColumnTypeGeometry           ColumnType = "geometry"
+	ColumnTypePoint              ColumnType = "point"
+	ColumnTypeLineString         ColumnType = "linestring"
+	ColumnTypePolygon            ColumnType = "polygon"
+	ColumnTypeMultiPolygon       ColumnType = "multipolygon"
+	ColumnTypeGeometryCollection ColumnType = "geometrycollection"
+
 	// Special column types
 	ColumnTypeEnum ColumnType = "enum"
 	ColumnTypeSet  ColumnType = "set"
 	ColumnTypeJson ColumnType = "json"
 )
 
+// columnTypes lists every known column type.
+var columnTypes = []ColumnType{
+	ColumnTypeInt, ColumnTypeBigInt, ColumnTypeSmallInt, ColumnTypeTinyInt, ColumnTypeMediumInt,
+	ColumnTypeFloat, ColumnTypeDouble, ColumnTypeDecimal,
+	ColumnTypeChar, ColumnTypeVarchar, ColumnTypeText, ColumnTypeMediumText, ColumnTypeLongText,
+	ColumnTypeDate, ColumnTypeDateTime, ColumnTypeTime, ColumnTypeTimestamp, ColumnTypeTimestampTz, ColumnTypeDateTimeTz, ColumnTypeYear,
+	ColumnTypeBinary, ColumnTypeVarBinary,
+	ColumnTypeGeometry, ColumnTypePoint, ColumnTypeLineString, ColumnTypePolygon, ColumnTypeMultiPolygon, ColumnTypeGeometryCollection,
+	ColumnTypeEnum, ColumnTypeSet, ColumnTypeJson,
+}
+
+// IsValid checks if the column type is one of the known column types.
+func (ct ColumnType) IsValid() bool {
+	return slices.Contains(columnTypes, ct)
+}
+
 // IsNumeric checks if the column type is a numeric type.
 func (ct ColumnType) IsNumeric() bool {
 	switch ct {
@@ -61,7 +91,17 @@ func (ct ColumnType) IsString() bool {
 // IsTime checks if the column type is a time-related type.
 func (ct ColumnType) IsTime() bool {
 	switch ct {
-	case ColumnTypeDate, ColumnTypeDateTime, ColumnTypeTime, ColumnTypeTimestamp:
+	case ColumnTypeDate, ColumnTypeDateTime, ColumnTypeTime, ColumnTypeTimestamp,
+		ColumnTypeTimestampTz, ColumnTypeDateTimeTz, ColumnTypeYear:
+		return true
+	}
+	return false
+}
+
+// HasFractionalSeconds checks if the column type holds a time of day, which can have fractional seconds.
+func (ct ColumnType) HasFractionalSeconds() bool {
+	switch ct {
+	case ColumnTypeDateTime, ColumnTypeTime, ColumnTypeTimestamp, ColumnTypeTimestampTz, ColumnTypeDateTimeTz:
 		return true
 	}
 	return false
@@ -85,13 +125,22 @@ func (ct ColumnType) IsBinary() bool {
 	return false
 }
 
+// IsSpatial checks if the column type is a spatial (geometry) type.
+func (ct ColumnType) IsSpatial() bool {
+	switch ct {
+	case ColumnTypeGeometry, ColumnTypePoint, ColumnTypeLineString, ColumnTypePolygon, ColumnTypeMultiPolygon, ColumnTypeGeometryCollection:
+		return true
+	}
+	return false
+}
+
 // IsEnum checks if the column type is an enum type.
 func (ct ColumnType) IsEnum() bool {
 	return ct == ColumnTypeEnum
 }
 
 // KindOf checks if the column type belongs to a specific primitive type category.
-// The supported primitive categories are: "int", "string", "time", "float", "binary".
+// The supported primitive categories are: "int", "string", "time", "float", "binary", "spatial".
 func (ct ColumnType) KindOf(primitive string) bool {
 	switch primitive {
 	case "int", "integer":
@@ -104,6 +153,8 @@ func (ct ColumnType) KindOf(primitive string) bool {
 		return ct.IsFloat()
 	case "binary":
 		return ct.IsBinary()
+	case "spatial":
+		return ct.IsSpatial()
 	}
 	return false
 }
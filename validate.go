@@ -0,0 +1,173 @@
+package blackhole
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// validate runs the schema level checks over every blueprint before it is built.
+func (s *Schema) validate() error {
+	s.warnings = []string{}
+	for _, st := range s.statements {
+		bp, ok := st.(*Blueprint)
+		if !ok {
+			continue
+		}
+		if err := s.lintReservedWords(bp); err != nil {
+			return err
+		}
+		if err := bp.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks the column definitions of the blueprint for modifiers that do not fit the column,
+// and its indexes for columns that cannot be indexed that way.
+func (b *Blueprint) validate() error {
+	if b.err != nil {
+		return fmt.Errorf("blackhole: table %q: %w", b.GetTable(), b.err)
+	}
+	columns := map[string]*Column{}
+	for _, d := range b.Definitions() {
+		c, ok := d.(*Column)
+		if !ok {
+			continue
+		}
+		if err := c.validate(); err != nil {
+			return fmt.Errorf("blackhole: table %q: %w", b.GetTable(), err)
+		}
+		columns[c.GetName()] = c
+	}
+
+	for _, m := range b.modifiedColumns() {
+		if err := m.Column().validate(); err != nil {
+			return fmt.Errorf("blackhole: table %q: %w", b.GetTable(), err)
+		}
+	}
+
+	for _, i := range b.indexes() {
+		if err := i.validate(columns); err != nil {
+			return fmt.Errorf("blackhole: table %q: %w", b.GetTable(), err)
+		}
+	}
+	return nil
+}
+
+// validate checks the index against the columns defined on the same blueprint.
+// Columns that are not defined on the blueprint are assumed to exist on the table and are not checked.
+func (i *Index) validate(columns map[string]*Column) error {
+	if i.Type != IndexTypeSpatial {
+		return nil
+	}
+	for _, name := range i.Columns {
+		c, ok := columns[name]
+		if !ok {
+			continue
+		}
+		if !c.GetDataType().IsSpatial() {
+			return fmt.Errorf("column %q: spatial indexes can only be placed on spatial columns, got %s", name, c.GetDataType())
+		}
+		if c.GetNullable() == nil || c.GetNullable().Is() {
+			return fmt.Errorf("column %q: spatial indexes can only be placed on not null columns", name)
+		}
+	}
+	return nil
+}
+
+// validate checks that the modifiers set on the column are allowed for its type.
+func (c *Column) validate() error {
+	if (c.GetCharSet() != "" || c.GetCollation() != "") && !c.GetDataType().IsString() {
+		return fmt.Errorf("column %q: character set and collation can only be set on string columns, got %s", c.GetName(), c.GetDataType())
+	}
+	if c.GetGenerated() != nil && c.GetDefaultValue() != nil {
+		return fmt.Errorf("column %q: generated columns cannot have a default value", c.GetName())
+	}
+	if c.GetGenerated() != nil && c.GetAutoIncrements() != nil {
+		return fmt.Errorf("column %q: generated columns cannot auto increment", c.GetName())
+	}
+	if c.GetGenerated() != nil && c.GetIdentity() != nil {
+		return fmt.Errorf("column %q: generated columns cannot be identity columns", c.GetName())
+	}
+	if c.GetEnumValues() != nil && c.GetEnumType() != "" {
+		return fmt.Errorf("column %q: enum values cannot be set on a column of the enum type %q", c.GetName(), c.GetEnumType())
+	}
+	if c.GetEnumValues() != nil && c.GetDefaultValue() != nil && c.GetDefaultValue().Get() != "NULL" &&
+		!slices.Contains(c.GetEnumValues().Values, c.GetDefaultValue().Get()) {
+		return fmt.Errorf("column %q: default value %q is not one of the enum values", c.GetName(), c.GetDefaultValue().Get())
+	}
+	return nil
+}
+
+// lintReservedWords checks the table and column names of the blueprint against the reserved word
+// catalogs of the target dialects, and warns or fails depending on the schema's policy.
+func (s *Schema) lintReservedWords(bp *Blueprint) error {
+	if s.reservedWordPolicy == ReservedWordsIgnore {
+		return nil
+	}
+
+	dialects := s.targetDialects()
+	for _, ident := range blueprintIdentifiers(bp) {
+		in := ReservedIn(ident.name, dialects...)
+		if len(in) == 0 {
+			continue
+		}
+
+		names := make([]string, len(in))
+		for i, d := range in {
+			names[i] = string(d)
+		}
+		msg := fmt.Sprintf("blackhole: %s name %q in table %q is a reserved word in %s", ident.kind, ident.name, bp.GetTable(), strings.Join(names, ", "))
+		if s.reservedWordPolicy == ReservedWordsError {
+			return fmt.Errorf("%s", msg)
+		}
+		s.warnings = append(s.warnings, msg)
+	}
+	return nil
+}
+
+// targetDialects returns the dialects the schema is checked against, defaulting to the grammar's own dialect.
+func (s *Schema) targetDialects() []Dialect {
+	if len(s.dialects) > 0 {
+		return s.dialects
+	}
+	return []Dialect{s.grammar.Dialect()}
+}
+
+// identifier is a name introduced by a blueprint along with what it names.
+type identifier struct {
+	kind string
+	name string
+}
+
+// blueprintIdentifiers collects the table and column names a blueprint (and its children) introduces.
+func blueprintIdentifiers(bp *Blueprint) []identifier {
+	var idents []identifier
+	switch bp.Mode() {
+	case "drop", "dropIfExists", "truncate":
+	case "rename":
+		_, table := SplitQualified(bp.GetRenameTo())
+		idents = append(idents, identifier{kind: "table", name: table})
+	default:
+		_, table := SplitQualified(bp.GetTable())
+		idents = append(idents, identifier{kind: "table", name: table})
+	}
+	for _, d := range bp.Definitions() {
+		switch def := d.(type) {
+		case *Column:
+			idents = append(idents, identifier{kind: "column", name: def.GetName()})
+		case *RenameColumn:
+			idents = append(idents, identifier{kind: "column", name: def.To()})
+		}
+	}
+	for _, child := range bp.Children() {
+		for _, ident := range blueprintIdentifiers(child) {
+			if ident.kind != "table" {
+				idents = append(idents, ident)
+			}
+		}
+	}
+	return idents
+}
@@ -0,0 +1,157 @@
+package blackhole
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchema_ReservedWords(t *testing.T) {
+	var cases = []struct {
+		name     string
+		policy   ReservedWordPolicy
+		dialects []Dialect
+		callback func(*Blueprint)
+		warnings int
+		err      string
+	}{
+		{
+			name:   "orders",
+			policy: ReservedWordsWarn,
+			callback: func(bp *Blueprint) {
+				bp.Id()
+				bp.Int("order")
+				bp.String("key", 64)
+			},
+			warnings: 2,
+		},
+		{
+			name:   "orders",
+			policy: ReservedWordsError,
+			callback: func(bp *Blueprint) {
+				bp.Id()
+				bp.Int("group")
+			},
+			err: `column name "group" in table "orders" is a reserved word in mysql`,
+		},
+		{
+			name:     "user",
+			policy:   ReservedWordsError,
+			dialects: []Dialect{DialectMySQL, DialectPostgres},
+			callback: func(bp *Blueprint) {
+				bp.Id()
+			},
+			err: `table name "user" in table "user" is a reserved word in postgres`,
+		},
+		{
+			name:   "orders",
+			policy: ReservedWordsIgnore,
+			callback: func(bp *Blueprint) {
+				bp.Int("order")
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(MySQL).ReservedWords(c.policy, c.dialects...)
+			schema.Create(c.name, c.callback)
+			_, err := schema.Build()
+
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Errorf("Expected error containing: %s", c.err)
+					t.Errorf("Got: %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Error: %s", err)
+			}
+
+			if len(schema.Warnings()) != c.warnings {
+				t.Errorf("Expected %d warnings, got %d: %v", c.warnings, len(schema.Warnings()), schema.Warnings())
+			}
+		})
+	}
+}
+
+func TestSchema_Validate_Columns(t *testing.T) {
+	var cases = []struct {
+		name     string
+		callback func(*Blueprint)
+		err      string
+	}{
+		{
+			name: "collation on integer",
+			callback: func(bp *Blueprint) {
+				bp.Int("age").Collation("utf8mb4_bin")
+			},
+			err: `column "age": character set and collation can only be set on string columns, got integer`,
+		},
+		{
+			name: "generated with default",
+			callback: func(bp *Blueprint) {
+				bp.String("full_name", 255).VirtualAs("concat(first_name, ' ', last_name)").Default("none")
+			},
+			err: `column "full_name": generated columns cannot have a default value`,
+		},
+		{
+			name: "generated auto increment",
+			callback: func(bp *Blueprint) {
+				bp.BigInt("seq").StoredAs("id + 1").AutoIncrement()
+			},
+			err: `column "seq": generated columns cannot auto increment`,
+		},
+		{
+			name: "spatial index on nullable column",
+			callback: func(bp *Blueprint) {
+				bp.Polygon("zone").SRID(4326)
+				bp.SpatialIndex("zone")
+			},
+			err: `column "zone": spatial indexes can only be placed on not null columns`,
+		},
+		{
+			name: "spatial index on string column",
+			callback: func(bp *Blueprint) {
+				bp.String("name", 255).NotNull()
+				bp.SpatialIndex("name")
+			},
+			err: `column "name": spatial indexes can only be placed on spatial columns, got varchar`,
+		},
+		{
+			name: "enum default removed",
+			callback: func(bp *Blueprint) {
+				previous := NewColumn("status", ColumnTypeEnum, 0).Enum("pending", "paid").Default("pending")
+				bp.AlterEnum(previous, "paid", "refunded")
+			},
+			err: `column "status": default value "pending" is not one of the enum values`,
+		},
+		{
+			name: "charset on text",
+			callback: func(bp *Blueprint) {
+				bp.Text("body").CharSet("utf8mb4")
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := NewSchema(MySQL)
+			schema.Create("validated", c.callback)
+			_, err := schema.Build()
+
+			if c.err == "" {
+				if err != nil {
+					t.Errorf("Error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("Expected error containing: %s", c.err)
+				t.Errorf("Got: %v", err)
+			}
+		})
+	}
+}
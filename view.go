@@ -0,0 +1,101 @@
+package blackhole
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type ViewAlgorithm string
+type ViewSecurity string
+
+const (
+	ViewAlgorithmUndefined ViewAlgorithm = "undefined"
+	ViewAlgorithmMerge     ViewAlgorithm = "merge"
+	ViewAlgorithmTempTable ViewAlgorithm = "temptable"
+)
+
+const (
+	ViewSecurityDefiner ViewSecurity = "definer"
+	ViewSecurityInvoker ViewSecurity = "invoker"
+)
+
+// ViewOptions holds the options a view is created with. Grammars ignore the options their dialect does not have.
+type ViewOptions struct {
+	Algorithm ViewAlgorithm
+	Security  ViewSecurity
+}
+
+// View represents a view, or materialized view, defined by a select query.
+type View struct {
+	mode    string
+	name    string
+	query   string
+	options ViewOptions
+	grammar *Grammar
+}
+
+// NewView creates a new View instance with the specified name, select query and options.
+func NewView(name, query string, options ViewOptions) *View {
+	return &View{
+		name:    name,
+		query:   query,
+		options: options,
+	}
+}
+
+// Grammar sets the grammar for the view.
+func (v *View) Grammar(grammar *Grammar) {
+	v.grammar = grammar
+}
+
+// GetName returns the name of the view.
+func (v *View) GetName() string {
+	return v.name
+}
+
+// GetQuery returns the select query the view is defined by.
+func (v *View) GetQuery() string {
+	return v.query
+}
+
+// GetOptions returns the options of the view.
+func (v *View) GetOptions() ViewOptions {
+	return v.options
+}
+
+// Mode returns the mode (create, createOrReplace, drop, createMaterialized, refreshMaterialized) of the view.
+func (v *View) Mode() string {
+	return v.mode
+}
+
+// setMode sets the mode of the view.
+func (v *View) setMode(mode string) *View {
+	v.mode = mode
+	return v
+}
+
+// isCreating returns whether the view is created, as a plain or a materialized view.
+func (v *View) isCreating() bool {
+	return v.mode == "create" || v.mode == "createOrReplace" || v.mode == "createMaterialized"
+}
+
+// References returns whether the query of the view refers to the given table or view name.
+func (v *View) References(name string) bool {
+	pattern := regexp.MustCompile("(?i)(^|[^\\w$])" + regexp.QuoteMeta(name) + "($|[^\\w$])")
+	return pattern.MatchString(v.query)
+}
+
+// Build builds the SQL statement for the view using the associated grammar.
+func (v *View) Build() (string, error) {
+	switch v.mode {
+	case "create", "createOrReplace":
+		return (*v.grammar).CompileCreateView(v)
+	case "drop":
+		return (*v.grammar).CompileDropView(v)
+	case "createMaterialized":
+		return (*v.grammar).CompileCreateMaterializedView(v)
+	case "refreshMaterialized":
+		return (*v.grammar).CompileRefreshMaterializedView(v)
+	}
+	return "", fmt.Errorf("blackhole: invalid view mode given : %s", v.mode)
+}